@@ -2,13 +2,16 @@ package socialtoken
 
 import (
 	"errors"
-	"math"
 
+	"github.com/Get-Cache/Privi/contracts/socialtoken/amm"
 	"github.com/shopspring/decimal"
 )
 
 /* -------------------------------------------------------------------------------------------------
-integral: this function determines the integral of the AMM curve.
+integral: this function determines the integral of the AMM curve. Only reached for the legacy
+          curve types below (LINEAR, QUADRATIC, EXPONENTIAL, SIGMOID); CONSTANT_PRODUCT and LMSR
+          are dispatched to the amm subpackage registry by buyingSocialTokens/selling_social_tokens/
+          getMarketPrice before this function is ever called.
 ------------------------------------------------------------------------------------------------- */
 
 func integral(AMM string, upperBound decimal.Decimal, lowerBound decimal.Decimal,
@@ -26,17 +29,15 @@ func integral(AMM string, upperBound decimal.Decimal, lowerBound decimal.Decimal
 		return multiplier.Mul(integral).Div(THREE_DECIMAL), err
 
 	case EXPONENTIAL_AMM:
-		multiplier := targetPrice.Mul(Exponent(EXP_DECIMAL, targetSupply.Neg()))
-		integral, err := saveSubstraction(Exponent(EXP_DECIMAL, upperBound), Exponent(EXP_DECIMAL, lowerBound))
+		multiplier := targetPrice.Mul(DecExp(targetSupply.Neg(), AMM_DECIMAL_PRECISION))
+		integral, err := saveSubstraction(DecExp(upperBound, AMM_DECIMAL_PRECISION), DecExp(lowerBound, AMM_DECIMAL_PRECISION))
 		return multiplier.Mul(integral), err
 
 	case SIGMOID_AMM:
-		// upper := (upperBound + math.Log(1+math.Exp(-upperBound+targetSupply)))
-		upperExpFloat, _ := (Exponent(EXP_DECIMAL, (upperBound.Neg().Add(targetSupply))).Add(ONE_DECIMAL)).Float64()
-		upper := (decimal.NewFromFloat(math.Log(upperExpFloat))).Add(upperBound)
-		// lower := (lowerBound + math.Log(1+math.Exp(-lowerBound+targetSupply)))
-		lowerExpFloat, _ := (Exponent(EXP_DECIMAL, (lowerBound.Neg().Add(targetSupply))).Add(ONE_DECIMAL)).Float64()
-		lower := (decimal.NewFromFloat(math.Log(lowerExpFloat))).Add(lowerBound)
+		// upper := (upperBound + ln(1+e^(-upperBound+targetSupply)))
+		upper := DecLn(DecExp(upperBound.Neg().Add(targetSupply), AMM_DECIMAL_PRECISION).Add(ONE_DECIMAL), AMM_DECIMAL_PRECISION).Add(upperBound)
+		// lower := (lowerBound + ln(1+e^(-lowerBound+targetSupply)))
+		lower := DecLn(DecExp(lowerBound.Neg().Add(targetSupply), AMM_DECIMAL_PRECISION).Add(ONE_DECIMAL), AMM_DECIMAL_PRECISION).Add(lowerBound)
 		integral, err := saveSubstraction(upper, lower)
 		return targetPrice.Div(TWO_DECIMAL).Mul(integral), err
 	}
@@ -50,6 +51,10 @@ marketPrice: this function determines the market price.
 func getMarketPrice(AMM string, supplyReleased decimal.Decimal, initialSupply decimal.Decimal,
 	targetPrice decimal.Decimal, targetSupply decimal.Decimal) (decimal.Decimal, error) {
 
+	if curve, ok := amm.Get(AMM); ok {
+		return curve.Price(supplyReleased, initialSupply, targetPrice, targetSupply)
+	}
+
 	effectiveSupply := decimal.Max(decimal.Zero, supplyReleased.Sub(initialSupply))
 	// if err != nil {
 	// 	return decimal.Zero, err
@@ -65,12 +70,12 @@ func getMarketPrice(AMM string, supplyReleased decimal.Decimal, initialSupply de
 		return multiplier.Mul(effectiveSupply.Pow(TWO_DECIMAL)), nil
 
 	case EXPONENTIAL_AMM:
-		multiplier := targetPrice.Mul(Exponent(EXP_DECIMAL, targetSupply.Neg()))
-		return multiplier.Mul(EXP_DECIMAL.Pow(supplyReleased)), nil
+		multiplier := targetPrice.Mul(DecExp(targetSupply.Neg(), AMM_DECIMAL_PRECISION))
+		return multiplier.Mul(DecExp(supplyReleased, AMM_DECIMAL_PRECISION)), nil
 
 	case SIGMOID_AMM:
-		// 	return targetPrice * (1. / (1 + math.Exp(-effectiveSupply+targetSupply))), nil
-		return targetPrice.Mul(ONE_DECIMAL.Div(Exponent(EXP_DECIMAL, (effectiveSupply.Neg().Add(targetSupply))).Add(ONE_DECIMAL))), nil
+		// 	return targetPrice * (1. / (1 + e^(-effectiveSupply+targetSupply))), nil
+		return targetPrice.Mul(ONE_DECIMAL.Div(DecExp(effectiveSupply.Neg().Add(targetSupply), AMM_DECIMAL_PRECISION).Add(ONE_DECIMAL))), nil
 	}
 	return decimal.Zero, errors.New("ERROR COMPUTING GETTING THE MARKET PRICE. ")
 }
@@ -81,6 +86,9 @@ buyingSocialTokens: this function determines the amount of X of Funding Tokens t
 ------------------------------------------------------------------------------------------------- */
 func buyingSocialTokens(AMM string, supplyReleased decimal.Decimal, initialSupply decimal.Decimal,
 	amount decimal.Decimal, targetPrice decimal.Decimal, targetSupply decimal.Decimal) (decimal.Decimal, error) {
+	if curve, ok := amm.Get(AMM); ok {
+		return curve.Buy(supplyReleased, initialSupply, amount, targetPrice, targetSupply)
+	}
 	effectiveSupply := decimal.Max(decimal.Zero, supplyReleased.Sub(initialSupply))
 	//effectiveSupply, err := saveSubstraction(supplyReleased, initialSupply)
 	// if err != nil {
@@ -98,6 +106,10 @@ selling_social_tokens: this function determines the amount of X of Funding Token
 func selling_social_tokens(AMM string, supplyReleased decimal.Decimal, initialSupply decimal.Decimal,
 	sellingAmount decimal.Decimal, spread decimal.Decimal, targetPrice decimal.Decimal, targetSupply decimal.Decimal) (decimal.Decimal, error) {
 
+	if curve, ok := amm.Get(AMM); ok {
+		return curve.Sell(supplyReleased, initialSupply, sellingAmount, spread, targetPrice, targetSupply)
+	}
+
 	// Compute supply left after selling it //
 	effectiveSupply := decimal.Max(decimal.Zero, supplyReleased.Sub(initialSupply))
 	// effectiveSupply, err := saveSubstraction(supplyReleased, initialSupply)
@@ -113,5 +125,31 @@ func selling_social_tokens(AMM string, supplyReleased decimal.Decimal, initialSu
 	return fundingAmount.Mul(ONE_DECIMAL.Sub(spread)), err
 }
 
+/* -------------------------------------------------------------------------------------------------
+Exported wrappers around the unexported curve math above, kept thin on purpose: they exist so
+tooling outside this package (cmd/ammvectors, which regenerates the testdata/vectors corpus) can drive
+the exact same code path the chaincode handlers use, rather than re-implementing the curves.
+------------------------------------------------------------------------------------------------- */
+
+func Integral(AMM string, upperBound decimal.Decimal, lowerBound decimal.Decimal,
+	targetPrice decimal.Decimal, targetSupply decimal.Decimal) (decimal.Decimal, error) {
+	return integral(AMM, upperBound, lowerBound, targetPrice, targetSupply)
+}
+
+func GetMarketPrice(AMM string, supplyReleased decimal.Decimal, initialSupply decimal.Decimal,
+	targetPrice decimal.Decimal, targetSupply decimal.Decimal) (decimal.Decimal, error) {
+	return getMarketPrice(AMM, supplyReleased, initialSupply, targetPrice, targetSupply)
+}
+
+func BuyingSocialTokens(AMM string, supplyReleased decimal.Decimal, initialSupply decimal.Decimal,
+	amount decimal.Decimal, targetPrice decimal.Decimal, targetSupply decimal.Decimal) (decimal.Decimal, error) {
+	return buyingSocialTokens(AMM, supplyReleased, initialSupply, amount, targetPrice, targetSupply)
+}
+
+func SellingSocialTokens(AMM string, supplyReleased decimal.Decimal, initialSupply decimal.Decimal,
+	sellingAmount decimal.Decimal, spread decimal.Decimal, targetPrice decimal.Decimal, targetSupply decimal.Decimal) (decimal.Decimal, error) {
+	return selling_social_tokens(AMM, supplyReleased, initialSupply, sellingAmount, spread, targetPrice, targetSupply)
+}
+
 /* -------------------------------------------------------------------------------------------------
 ------------------------------------------------------------------------------------------------- */
\ No newline at end of file
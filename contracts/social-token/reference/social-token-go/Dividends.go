@@ -0,0 +1,329 @@
+package socialtoken
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/Get-Cache/Privi/contracts/coinbalance"
+	"github.com/Get-Cache/Privi/utils"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+dividendEpochSeconds: this function returns how long a pool's DividendFreq makes it wait between
+             DistributeSocialDividends sweeps. Unrecognised frequencies fall back to DAILY.
+------------------------------------------------------------------------------------------------- */
+
+func dividendEpochSeconds(freq string) int64 {
+	switch freq {
+	case WEEKLY_PAYMENT:
+		return WEEKLY_PAYMENT_SECONDS
+	case MONTHLY_PAYMENT:
+		return MONTHLY_PAYMENT_SECONDS
+	default:
+		return DAILY_PAYMENT_SECONDS
+	}
+}
+
+/* -------------------------------------------------------------------------------------------------
+getDividendSnapshot: this function returns a holder's dividend checkpoint for a pool, defaulting to
+             a zero balance snapshot dated at the pool's last sweep if none has been recorded yet.
+------------------------------------------------------------------------------------------------- */
+
+func getDividendSnapshot(stub shim.ChaincodeStubInterface, poolState SocialPoolState,
+	poolAddress string, holder string) (DividendSnapshot, error) {
+
+	snapshot := DividendSnapshot{
+		PoolAddress: poolAddress,
+		Holder:      holder,
+		Balance:     decimal.Zero,
+		SinceDate:   poolState.LastDividendDate,
+	}
+	snapshotBytes, err := stub.GetState(IndexDividendSnapshots + poolAddress + "_" + holder)
+	if err != nil {
+		return snapshot, errors.New("ERROR: GETTING DIVIDEND SNAPSHOT FOR " + holder + ". " + err.Error())
+	}
+	if snapshotBytes == nil {
+		return snapshot, nil
+	}
+	if err := json.Unmarshal(snapshotBytes, &snapshot); err != nil {
+		return snapshot, err
+	}
+	return snapshot, nil
+}
+
+func putDividendSnapshot(stub shim.ChaincodeStubInterface, snapshot DividendSnapshot) error {
+	snapshotBytes, _ := json.Marshal(snapshot)
+	if err := stub.PutState(IndexDividendSnapshots+snapshot.PoolAddress+"_"+snapshot.Holder, snapshotBytes); err != nil {
+		return errors.New("ERROR: UPDATING DIVIDEND SNAPSHOT FOR " + snapshot.Holder + ". " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+computeDividendShares: this function weighs every current holder of pool.TokenSymbol by balance
+             times seconds held since their last snapshot (floored at the pool's last sweep), and
+             splits poolState.DividendFunds across them in that proportion. It returns each
+             holder's share alongside the refreshed snapshot paying them out would leave behind, so
+             callers can either pay everyone (DistributeSocialDividends), pay one holder and persist
+             only their snapshot (ClaimSocialDividend), or just read a holder's share (GetPendingDividend).
+------------------------------------------------------------------------------------------------- */
+
+func computeDividendShares(stub shim.ChaincodeStubInterface, pool SocialPool, poolState SocialPoolState,
+	now int64) (map[string]decimal.Decimal, map[string]DividendSnapshot, error) {
+
+	shares := make(map[string]decimal.Decimal)
+	snapshots := make(map[string]DividendSnapshot)
+	if poolState.DividendFunds.IsZero() {
+		return shares, snapshots, nil
+	}
+
+	balances, err := coinbalance.GetBalancesByToken(stub, pool.TokenSymbol)
+	if err != nil {
+		return nil, nil, errors.New("ERROR: GETTING HOLDERS OF " + pool.TokenSymbol + ". " + err.Error())
+	}
+
+	weights := make(map[string]decimal.Decimal)
+	totalWeight := decimal.Zero
+	for holder, balance := range balances {
+		if !balance.Amount.IsPositive() {
+			continue
+		}
+		snapshot, err := getDividendSnapshot(stub, poolState, pool.PoolAddress, holder)
+		if err != nil {
+			return nil, nil, err
+		}
+		since := snapshot.SinceDate
+		if since < poolState.LastDividendDate {
+			since = poolState.LastDividendDate
+		}
+		heldSeconds := now - since
+		if heldSeconds < 0 {
+			heldSeconds = 0
+		}
+		weight := balance.Amount.Mul(decimal.NewFromInt(heldSeconds))
+		weights[holder] = weight
+		totalWeight = totalWeight.Add(weight)
+
+		snapshot.Balance = balance.Amount
+		snapshots[holder] = snapshot
+	}
+
+	if totalWeight.IsZero() {
+		return shares, snapshots, nil
+	}
+	for holder, weight := range weights {
+		shares[holder] = poolState.DividendFunds.Mul(weight).Div(totalWeight)
+	}
+	return shares, snapshots, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+distributeSocialDividends: this function lets the pool Creator or ADMIN_ROLE sweep a pool's
+             accrued DividendFunds to every current holder of its TokenSymbol, pro-rated by balance
+             and time held since the pool's last sweep. Meant to be called by a cron/keeper process
+             once per DividendFreq epoch; holders who would rather not wait for it can pull their
+             own share early with claimSocialDividend. Args is an array containing one json with:
+Requester               string                   	   // Id of whoever is triggering the sweep
+PoolAddress             string  			  	       // Address of the social pool
+Hash                    string                   	   // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func DistributeSocialDividends(stub shim.ChaincodeStubInterface, input *DistributeSocialDividendsRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolAdmin(stub, pool, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	timestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+	now := int64(timestamp.Seconds)
+	if now-poolState.LastDividendDate < dividendEpochSeconds(pool.DividendFreq) {
+		return shim.Error("ERROR: DIVIDEND EPOCH FOR POOL " + pool.PoolAddress + " IS NOT DUE YET.")
+	}
+
+	shares, snapshots, err := computeDividendShares(stub, pool, poolState, now)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	var transactions []coinbalance.Transfer
+	distributed := decimal.Zero
+	for holder, share := range shares {
+		if !share.IsPositive() {
+			continue
+		}
+		dividendTransfer := coinbalance.TransferRequest{
+			Type:   "Social_Token_Dividend_Distribution",
+			Token:  pool.FundingToken,
+			Amount: share,
+			From:   pool.PoolAddress,
+			To:     holder,
+		}
+		holderTransactions, err := multiTransfer(stub, poolState, pool.PoolAddress, "distributeSocialDividends", dividendTransfer)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		transactions = append(transactions, holderTransactions[:]...)
+		distributed = distributed.Add(share)
+
+		snapshot := snapshots[holder]
+		snapshot.SinceDate = now
+		snapshot.LastClaim = now
+		if err := putDividendSnapshot(stub, snapshot); err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
+	poolState.DividendFunds, err = saveSubstraction(poolState.DividendFunds, distributed)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState.LastDividendDate = now
+	if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+claimSocialDividend: this function lets a single holder pull their own pro-rata share of a pool's
+             DividendFunds, without waiting for distributeSocialDividends to sweep every holder.
+             Paying them out shrinks DividendFunds by exactly their share, so whoever sweeps or
+             claims afterwards still divides what is left correctly. Args is an array containing
+             one json with:
+Requester               string                   	   // Id of the holder claiming their dividend
+PoolAddress             string  			  	       // Address of the social pool
+Hash                    string                   	   // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func ClaimSocialDividend(stub shim.ChaincodeStubInterface, input *ClaimSocialDividendRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	timestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+	now := int64(timestamp.Seconds)
+
+	shares, snapshots, err := computeDividendShares(stub, pool, poolState, now)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	share, ok := shares[input.Requester]
+	if !ok || !share.IsPositive() {
+		return shim.Error("ERROR: " + input.Requester + " HAS NO PENDING DIVIDEND ON POOL " + pool.PoolAddress + ".")
+	}
+
+	dividendTransfer := coinbalance.TransferRequest{
+		Type:   "Social_Token_Dividend_Claim",
+		Token:  pool.FundingToken,
+		Amount: share,
+		From:   pool.PoolAddress,
+		To:     input.Requester,
+	}
+	transactions, err := multiTransfer(stub, poolState, pool.PoolAddress, "claimSocialDividend", dividendTransfer)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState.DividendFunds, err = saveSubstraction(poolState.DividendFunds, share)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	snapshot := snapshots[input.Requester]
+	snapshot.SinceDate = now
+	snapshot.LastClaim = now
+	if err := putDividendSnapshot(stub, snapshot); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+getPendingDividend: this function returns what claimSocialDividend would currently pay a holder,
+             without transferring anything or touching their snapshot. Args:
+PoolAddress             string  			  // Address of the social pool
+Holder                  string  			  // Id of the holder to quote
+------------------------------------------------------------------------------------------------- */
+
+func GetPendingDividend(stub shim.ChaincodeStubInterface, args []string) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, args[0])
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState, err := GetSocialPoolState(stub, args[0])
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	timestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+
+	shares, _, err := computeDividendShares(stub, pool, poolState, int64(timestamp.Seconds))
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	pending := shares[args[1]]
+	pendingBytes, _ := json.Marshal(pending)
+	return shim.Success(pendingBytes)
+}
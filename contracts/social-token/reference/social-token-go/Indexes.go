@@ -0,0 +1,214 @@
+/*--------------------------------------------------------------------------
+----------------------------------------------------------------------------
+   SECONDARY INDEXES: CREATOR AND AMM-TYPE LOOKUPS WITHOUT A RICH QUERY
+----------------------------------------------------------------------------
+-------------------------------------------------------------------------- */
+
+package socialtoken
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/Get-Cache/Privi/utils"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/peer"
+)
+
+/* -------------------------------------------------------------------------------------------------
+addPoolToIndex / removePoolFromIndex / getPoolsFromIndex: maintain a composite-key secondary index
+             under indexPrefix, one CreateCompositeKey(indexPrefix, []string{bucketKey, poolAddress})
+             entry per pool rather than a single JSON-array blob per bucket. This is deliberately NOT
+             the JSON-encoded-list shape IndexSymbolTokenIDs uses: every pool sharing a creator or AMM
+             type would otherwise read-modify-write the same bucket key, a known Fabric MVCC hot-key
+             that invalidates concurrent CreateSocialToken calls by the same creator in one block, and
+             the blob would grow unboundedly instead of supporting a scan. getPoolsFromIndex walks the
+             bucket with GetStateByPartialCompositeKey instead. GetSocialPoolOfToken does not sit on
+             top of this index: it was already rebuilt onto IndexTokenIDToPool/IndexSymbolTokenIDs in
+             chunk1-5, before this index existed, so there is nothing left here for it to call.
+------------------------------------------------------------------------------------------------- */
+
+func getPoolsFromIndex(stub shim.ChaincodeStubInterface, indexPrefix string, bucketKey string) ([]string, error) {
+	iterator, err := stub.GetStateByPartialCompositeKey(indexPrefix, []string{bucketKey})
+	if err != nil {
+		return nil, errors.New("ERROR: SCANNING POOL INDEX " + indexPrefix + bucketKey + ". " + err.Error())
+	}
+	defer iterator.Close()
+
+	var pools []string
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return nil, errors.New("ERROR: SCANNING POOL INDEX " + indexPrefix + bucketKey + ". " + err.Error())
+		}
+		_, parts, err := stub.SplitCompositeKey(kv.Key)
+		if err != nil {
+			return nil, errors.New("ERROR: PARSING POOL INDEX KEY " + kv.Key + ". " + err.Error())
+		}
+		if len(parts) != 2 {
+			continue
+		}
+		pools = append(pools, parts[1])
+	}
+	return pools, nil
+}
+
+func addPoolToIndex(stub shim.ChaincodeStubInterface, indexPrefix string, bucketKey string, poolAddress string) error {
+	key, err := stub.CreateCompositeKey(indexPrefix, []string{bucketKey, poolAddress})
+	if err != nil {
+		return errors.New("ERROR: BUILDING POOL INDEX KEY " + indexPrefix + bucketKey + ". " + err.Error())
+	}
+	if err := stub.PutState(key, []byte(poolAddress)); err != nil {
+		return errors.New("ERROR: STORING POOL INDEX " + indexPrefix + bucketKey + ". " + err.Error())
+	}
+	return nil
+}
+
+func removePoolFromIndex(stub shim.ChaincodeStubInterface, indexPrefix string, bucketKey string, poolAddress string) error {
+	key, err := stub.CreateCompositeKey(indexPrefix, []string{bucketKey, poolAddress})
+	if err != nil {
+		return errors.New("ERROR: BUILDING POOL INDEX KEY " + indexPrefix + bucketKey + ". " + err.Error())
+	}
+	if err := stub.DelState(key); err != nil {
+		return errors.New("ERROR: CLEARING POOL INDEX " + indexPrefix + bucketKey + ". " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+clearPoolIndex: deletes every composite-key entry under indexPrefix, regardless of bucket. Used by
+             RebuildIndexes so a rebuild starts from an empty index instead of layering new entries
+             on top of however the index had drifted.
+------------------------------------------------------------------------------------------------- */
+
+func clearPoolIndex(stub shim.ChaincodeStubInterface, indexPrefix string) error {
+	iterator, err := stub.GetStateByPartialCompositeKey(indexPrefix, []string{})
+	if err != nil {
+		return errors.New("ERROR: SCANNING POOL INDEX " + indexPrefix + " FOR REBUILD. " + err.Error())
+	}
+	defer iterator.Close()
+
+	var keys []string
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return errors.New("ERROR: SCANNING POOL INDEX " + indexPrefix + " FOR REBUILD. " + err.Error())
+		}
+		keys = append(keys, kv.Key)
+	}
+	for _, key := range keys {
+		if err := stub.DelState(key); err != nil {
+			return errors.New("ERROR: CLEARING POOL INDEX " + indexPrefix + " FOR REBUILD. " + err.Error())
+		}
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+ListSocialPoolsByCreator: returns every PoolAddress registered by creator. Args is an array containing
+             one string:
+Creator                 string                        // Address of the pool creator to look up
+------------------------------------------------------------------------------------------------- */
+
+func ListSocialPoolsByCreator(stub shim.ChaincodeStubInterface, args []string) peer.Response {
+	if err := utils.ValidateArgsLen(args, 1); err != nil {
+		return utils.Error(err)
+	}
+	pools, err := getPoolsFromIndex(stub, IndexCreatorPools, args[0])
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolsBytes, err := json.Marshal(pools)
+	if err != nil {
+		return utils.Error(err)
+	}
+	return shim.Success(poolsBytes)
+}
+
+/* -------------------------------------------------------------------------------------------------
+ListSocialPoolsByTokenType: returns every PoolAddress registered under the given AMM curve type. Every
+             SocialPool's underlying coinbalance.Token is registered as TokenType SOCIAL_TOKEN, so AMM
+             is the only field that actually varies pool-to-pool; this indexes that instead. Args is
+             an array containing one string:
+AMM                     string                        // One of AMM_TYPES to look up
+------------------------------------------------------------------------------------------------- */
+
+func ListSocialPoolsByTokenType(stub shim.ChaincodeStubInterface, args []string) peer.Response {
+	if err := utils.ValidateArgsLen(args, 1); err != nil {
+		return utils.Error(err)
+	}
+	pools, err := getPoolsFromIndex(stub, IndexAMMTypePools, args[0])
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolsBytes, err := json.Marshal(pools)
+	if err != nil {
+		return utils.Error(err)
+	}
+	return shim.Success(poolsBytes)
+}
+
+/* -------------------------------------------------------------------------------------------------
+RebuildIndexes: admin-only migration path that scans every SocialPool under IndexSocialPools and
+             re-emits IndexCreatorPools/IndexAMMTypePools from scratch, so a deployment that predates
+             these indexes (or one where they drifted) can recover them without touching pool or token
+             data. Args is an array containing one json with:
+Requester               string                        // Id of the admin running the rebuild
+Hash                    string                        // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func RebuildIndexes(stub shim.ChaincodeStubInterface, input *RebuildIndexesRequest) peer.Response {
+	if err := assertAdmin(stub, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if err := clearPoolIndex(stub, IndexCreatorPools); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := clearPoolIndex(stub, IndexAMMTypePools); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Pool keys are IndexSocialPools + a 0x-prefixed address; bounding the range on "0x" keeps the
+	// SOCIAL_POOL_STATES/SOCIAL_POOL_VERIFIED/... keys that share the prefix out of the scan.
+	iterator, err := stub.GetStateByRange(IndexSocialPools+"0x", IndexSocialPools+"0x~")
+	if err != nil {
+		return shim.Error("ERROR: SCANNING SOCIAL POOLS. " + err.Error())
+	}
+	defer iterator.Close()
+
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return shim.Error("ERROR: SCANNING SOCIAL POOLS. " + err.Error())
+		}
+		var pool SocialPool
+		if err := json.Unmarshal(kv.Value, &pool); err != nil {
+			return shim.Error("ERROR: PARSING SOCIAL POOL " + kv.Key + ". " + err.Error())
+		}
+		if err := addPoolToIndex(stub, IndexCreatorPools, pool.Creator, pool.PoolAddress); err != nil {
+			return shim.Error(err.Error())
+		}
+		if err := addPoolToIndex(stub, IndexAMMTypePools, pool.AMM, pool.PoolAddress); err != nil {
+			return shim.Error(err.Error())
+		}
+	}
+
+	return shim.Success(nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
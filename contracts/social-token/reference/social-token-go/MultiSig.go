@@ -0,0 +1,333 @@
+/*--------------------------------------------------------------------------
+----------------------------------------------------------------------------
+   OPERATOR MULTISIG: M-OF-N AUTHORIZATION FOR PRIVILEGED POOL OPERATIONS
+----------------------------------------------------------------------------
+-------------------------------------------------------------------------- */
+
+package socialtoken
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/Get-Cache/Privi/contracts/coinbalance"
+	"github.com/Get-Cache/Privi/contracts/socialtoken/auth"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/peer"
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+assertAndConsumeOpNonce: this function enforces that a (poolAddress, nonce) pair is used at most
+             once across SubmitSignedOp/ProposeOp/CoSignOp, tracked as a SET rather than the
+             strictly-increasing sequence assertAndConsumeNonce uses for single-signer requests,
+             since Operators proposing concurrently cannot agree on a sequential order in advance.
+------------------------------------------------------------------------------------------------- */
+
+func assertAndConsumeOpNonce(stub shim.ChaincodeStubInterface, poolAddress string, nonce int64) error {
+	key := IndexSocialPoolNonces + poolAddress + "_" + strconv.FormatInt(nonce, 10)
+	existing, err := stub.GetState(key)
+	if err != nil {
+		return errors.New("ERROR: GETTING OP NONCE STATE OF POOL " + poolAddress + ". " + err.Error())
+	}
+	if existing != nil {
+		return errors.New("ERROR: NONCE " + strconv.FormatInt(nonce, 10) + " FOR POOL " + poolAddress + " HAS ALREADY BEEN USED.")
+	}
+	if err := stub.PutState(key, []byte("true")); err != nil {
+		return errors.New("ERROR: STORING OP NONCE STATE OF POOL " + poolAddress + ". " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+getPendingSignedOp / putPendingSignedOp / deletePendingSignedOp: read/write access to the
+             PendingSignedOp ProposeOp opens and CoSignOp accumulates signatures on, keyed by the
+             same (poolAddress, nonce) pair assertAndConsumeOpNonce guards.
+------------------------------------------------------------------------------------------------- */
+
+func pendingSignedOpKey(poolAddress string, nonce int64) string {
+	return IndexPendingSignedOps + poolAddress + "_" + strconv.FormatInt(nonce, 10)
+}
+
+func getPendingSignedOp(stub shim.ChaincodeStubInterface, poolAddress string, nonce int64) (PendingSignedOp, error) {
+	var pending PendingSignedOp
+	data, err := stub.GetState(pendingSignedOpKey(poolAddress, nonce))
+	if err != nil {
+		return pending, errors.New("ERROR: GETTING PENDING OP OF POOL " + poolAddress + ". " + err.Error())
+	}
+	if data == nil {
+		return pending, errors.New("ERROR: NO PENDING OP FOR POOL " + poolAddress + " NONCE " + strconv.FormatInt(nonce, 10) + ".")
+	}
+	if err := json.Unmarshal(data, &pending); err != nil {
+		return pending, errors.New("ERROR: PARSING PENDING OP OF POOL " + poolAddress + ". " + err.Error())
+	}
+	return pending, nil
+}
+
+func putPendingSignedOp(stub shim.ChaincodeStubInterface, pending PendingSignedOp) error {
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return errors.New("ERROR: ENCODING PENDING OP OF POOL " + pending.PoolAddress + ". " + err.Error())
+	}
+	if err := stub.PutState(pendingSignedOpKey(pending.PoolAddress, pending.Nonce), data); err != nil {
+		return errors.New("ERROR: STORING PENDING OP OF POOL " + pending.PoolAddress + ". " + err.Error())
+	}
+	return nil
+}
+
+func deletePendingSignedOp(stub shim.ChaincodeStubInterface, poolAddress string, nonce int64) error {
+	if err := stub.DelState(pendingSignedOpKey(poolAddress, nonce)); err != nil {
+		return errors.New("ERROR: CLEARING PENDING OP OF POOL " + poolAddress + ". " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+executeSignedOp: this function dispatches an operator-quorum-approved op to the same helper a
+             single-signer flow would have called directly. It is the only thing SubmitSignedOp and
+             a threshold-reaching CoSignOp are for; Payload is the canonical JSON of the underlying
+             request for op.
+------------------------------------------------------------------------------------------------- */
+
+func executeSignedOp(stub shim.ChaincodeStubInterface, pool SocialPool, op string, payload string) (peer.Response, error) {
+
+	switch op {
+
+	case MINT_OP:
+		var transferRequest coinbalance.TransferRequest
+		if err := json.Unmarshal([]byte(payload), &transferRequest); err != nil {
+			return peer.Response{}, errors.New("ERROR: PARSING MINT PAYLOAD. " + err.Error())
+		}
+		poolState, err := GetSocialPoolState(stub, pool.PoolAddress)
+		if err != nil {
+			return peer.Response{}, err
+		}
+		transactions, err := mintSocialPoolTokens(stub, poolState, pool.PoolAddress, "submitSignedOp", &transferRequest)
+		if err != nil {
+			return peer.Response{}, err
+		}
+		// Keep SupplyReleased in sync like every other mint path (MakeBuySocialToken,
+		// MakeBridgeInSocialToken), so curve pricing never goes stale for a pool that has gone
+		// through the multisig path //
+		poolState.SupplyReleased = poolState.SupplyReleased.Add(transferRequest.Amount)
+		if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+			return peer.Response{}, err
+		}
+		updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+		return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil), nil
+
+	case BURN_OP:
+		var transferRequest coinbalance.TransferRequest
+		if err := json.Unmarshal([]byte(payload), &transferRequest); err != nil {
+			return peer.Response{}, errors.New("ERROR: PARSING BURN PAYLOAD. " + err.Error())
+		}
+		poolState, err := GetSocialPoolState(stub, pool.PoolAddress)
+		if err != nil {
+			return peer.Response{}, err
+		}
+		transactions, err := burnSocialPoolTokens(stub, poolState, pool.PoolAddress, "submitSignedOp", &transferRequest)
+		if err != nil {
+			return peer.Response{}, err
+		}
+		// Mirror MakeSellSocialToken's accounting for the same reason as MINT_OP above //
+		poolState.SupplyReleased, err = saveSubstraction(poolState.SupplyReleased, transferRequest.Amount)
+		if err != nil {
+			return peer.Response{}, err
+		}
+		if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+			return peer.Response{}, err
+		}
+		updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+		return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil), nil
+
+	case UPDATE_POOL_INFO_OP:
+		updated := pool
+		if err := json.Unmarshal([]byte(payload), &updated); err != nil {
+			return peer.Response{}, errors.New("ERROR: PARSING UPDATE POOL INFO PAYLOAD. " + err.Error())
+		}
+		// PoolAddress/Creator/TokenID identity cannot be changed through this path //
+		updated.PoolAddress = pool.PoolAddress
+		updated.Creator = pool.Creator
+		updated.TokenID = pool.TokenID
+		if err := updateSocialPoolInfo(stub, updated); err != nil {
+			return peer.Response{}, err
+		}
+		updateSocialPools := map[string]SocialPool{pool.PoolAddress: updated}
+		return generateOutput(updateSocialPools, nil, nil, nil, nil), nil
+
+	case REGISTER_TOKEN_OP:
+		// Idempotency guard: CreateSocialToken always initialises SocialPoolState in the same
+		// transaction it registers the underlying coinbalance token, so an existing state means
+		// this pool's token was already registered. Without this, replaying/re-approving
+		// REGISTER_TOKEN_OP would re-mint InitialSupply on every approval //
+		if _, err := GetSocialPoolState(stub, pool.PoolAddress); err == nil {
+			return peer.Response{}, errors.New("ERROR: POOL " + pool.PoolAddress + " ALREADY HAS A REGISTERED TOKEN.")
+		}
+		updateTokens, transactions, err := registerSocialToken(stub, pool, pool.PoolAddress)
+		if err != nil {
+			return peer.Response{}, err
+		}
+		poolState := SocialPoolState{
+			SupplyReleased: pool.InitialSupply,
+			DividendFunds:  decimal.Zero,
+		}
+		if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+			return peer.Response{}, err
+		}
+		updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+		return generateOutput(nil, updateSocialPoolStates, updateTokens, transactions, nil), nil
+
+	}
+	return peer.Response{}, errors.New("ERROR: UNKNOWN SIGNED OP " + op + ".")
+}
+
+/* -------------------------------------------------------------------------------------------------
+submitSignedOp: this function dispatches a privileged operation (mintSocialPoolTokens,
+             burnSocialPoolTokens, updateSocialPoolInfo, or registerSocialToken) against PoolAddress
+             once threshold of its Operators have already signed off-chain. Args is an array
+             containing one json with:
+Op                      string                        // One of MINT_OP, BURN_OP, UPDATE_POOL_INFO_OP, REGISTER_TOKEN_OP
+PoolAddress             string                        // Address of the social pool
+Payload                 string                        // Canonical JSON of the underlying request for Op
+Nonce                   int64                         // Consumed via assertAndConsumeOpNonce, never reused
+Signers                 []string                      // Operators[i].Operator for each signature in Signatures
+Signatures              []string                      // Signers[i]'s signature over auth.CanonicalMessage(Op, PoolAddress, Payload, Nonce)
+------------------------------------------------------------------------------------------------- */
+
+func SubmitSignedOp(stub shim.ChaincodeStubInterface, input *SubmitSignedOpRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if pool.Threshold <= 0 {
+		return shim.Error("ERROR: POOL " + input.PoolAddress + " HAS NO OPERATOR QUORUM CONFIGURED.")
+	}
+	if err := assertAndConsumeOpNonce(stub, input.PoolAddress, input.Nonce); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	operatorKeys := make([]string, len(pool.Operators))
+	for i, operator := range pool.Operators {
+		operatorKeys[i] = operator.Operator
+	}
+	message := auth.CanonicalMessage(input.Op, input.PoolAddress, input.Payload, input.Nonce)
+	if _, err := auth.VerifyAggregateBLS(stub, operatorKeys, pool.Threshold, message,
+		[]byte(input.Payload), input.Signers, input.Signatures); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	response, err := executeSignedOp(stub, pool, input.Op, input.Payload)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return response
+}
+
+/* -------------------------------------------------------------------------------------------------
+proposeOp: this function opens a PendingSignedOp with the proposer's own signature, for Operators who
+             cannot coordinate off-chain before the first signature is submitted. Args is an array
+             containing one json with:
+Proposer                string                        // Operators[i].Operator proposing the op
+Op                      string                        // One of MINT_OP, BURN_OP, UPDATE_POOL_INFO_OP, REGISTER_TOKEN_OP
+PoolAddress             string                        // Address of the social pool
+Payload                 string                        // Canonical JSON of the underlying request for Op
+Nonce                   int64                         // Reserved for this op via assertAndConsumeOpNonce
+Signature               string                        // Proposer's signature over auth.CanonicalMessage(Op, PoolAddress, Payload, Nonce)
+------------------------------------------------------------------------------------------------- */
+
+func ProposeOp(stub shim.ChaincodeStubInterface, input *ProposeOpRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if pool.Threshold <= 0 {
+		return shim.Error("ERROR: POOL " + input.PoolAddress + " HAS NO OPERATOR QUORUM CONFIGURED.")
+	}
+	if err := assertAndConsumeOpNonce(stub, input.PoolAddress, input.Nonce); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	operatorKeys := make([]string, len(pool.Operators))
+	for i, operator := range pool.Operators {
+		operatorKeys[i] = operator.Operator
+	}
+	message := auth.CanonicalMessage(input.Op, input.PoolAddress, input.Payload, input.Nonce)
+	if _, err := auth.VerifyAggregateBLS(stub, operatorKeys, 1, message,
+		[]byte(input.Payload), []string{input.Proposer}, []string{input.Signature}); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	pending := PendingSignedOp{
+		Op:          input.Op,
+		PoolAddress: input.PoolAddress,
+		Payload:     input.Payload,
+		Nonce:       input.Nonce,
+		Signers:     []string{input.Proposer},
+		Signatures:  []string{input.Signature},
+	}
+	if err := putPendingSignedOp(stub, pending); err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+coSignOp: this function appends Signer's signature to an already-proposed PendingSignedOp. Once the
+             accumulated signers reach the pool's Threshold, it dispatches Op itself rather than
+             waiting for a separate submitSignedOp call. Args is an array containing one json with:
+Signer                  string                        // Operators[i].Operator co-signing
+PoolAddress             string                        // Address of the social pool
+Nonce                   int64                         // Identifies which PendingSignedOp to co-sign
+Signature               string                        // Signer's signature over the pending op's canonical message
+------------------------------------------------------------------------------------------------- */
+
+func CoSignOp(stub shim.ChaincodeStubInterface, input *CoSignOpRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	pending, err := getPendingSignedOp(stub, input.PoolAddress, input.Nonce)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	operatorKeys := make([]string, len(pool.Operators))
+	for i, operator := range pool.Operators {
+		operatorKeys[i] = operator.Operator
+	}
+	message := auth.CanonicalMessage(pending.Op, pending.PoolAddress, pending.Payload, pending.Nonce)
+	if _, err := auth.VerifyAggregateBLS(stub, operatorKeys, 1, message,
+		[]byte(pending.Payload), []string{input.Signer}, []string{input.Signature}); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	pending.Signers = append(pending.Signers, input.Signer)
+	pending.Signatures = append(pending.Signatures, input.Signature)
+
+	signers, err := auth.VerifyAggregateBLS(stub, operatorKeys, pool.Threshold, message,
+		[]byte(pending.Payload), pending.Signers, pending.Signatures)
+	if err != nil {
+		// Threshold not reached yet: persist the accumulated signature and wait for the next CoSignOp //
+		if err := putPendingSignedOp(stub, pending); err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(nil)
+	}
+	_ = signers
+
+	if err := deletePendingSignedOp(stub, input.PoolAddress, input.Nonce); err != nil {
+		return shim.Error(err.Error())
+	}
+	response, err := executeSignedOp(stub, pool, pending.Op, pending.Payload)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return response
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
@@ -0,0 +1,180 @@
+/*--------------------------------------------------------------------------
+----------------------------------------------------------------------------
+   STREAMING PAGINATION: LIST POOLS/POOL STATES WITHOUT LOADING THEM ALL
+----------------------------------------------------------------------------
+-------------------------------------------------------------------------- */
+
+package socialtoken
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/Get-Cache/Privi/utils"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/peer"
+)
+
+/* -------------------------------------------------------------------------------------------------
+ListSocialPools: pages through every registered SocialPool via GetStateByRangeWithPagination instead
+             of generateOutput's load-everything-into-one-map shape, so the response stays bounded as
+             the number of pools grows. Each returned pool is re-fetched through GetSocialPoolInfo so
+             Verified/GlobalLocked are still the live read-side projections, not whatever was last
+             written to the stored blob. Args is an array containing two strings:
+PageSize                string                        // Max pools to return this page, parsed as int32
+Bookmark                string                        // Opaque cursor from a previous call's NextBookmark, or "" for the first page
+------------------------------------------------------------------------------------------------- */
+
+func ListSocialPools(stub shim.ChaincodeStubInterface, args []string) peer.Response {
+	pageSize, bookmark, err := parsePageArgs(args)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Pool keys are IndexSocialPools + a 0x-prefixed address; bounding the range on "0x" keeps the
+	// SOCIAL_POOL_STATES/SOCIAL_POOL_VERIFIED/... keys that share the prefix out of the page.
+	iterator, metadata, err := stub.GetStateByRangeWithPagination(IndexSocialPools+"0x", IndexSocialPools+"0x~", pageSize, bookmark)
+	if err != nil {
+		return shim.Error("ERROR: LISTING SOCIAL POOLS. " + err.Error())
+	}
+	defer iterator.Close()
+
+	var pools []SocialPool
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return shim.Error("ERROR: LISTING SOCIAL POOLS. " + err.Error())
+		}
+		pool, err := GetSocialPoolInfo(stub, strings.TrimPrefix(kv.Key, IndexSocialPools))
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		pools = append(pools, pool)
+	}
+
+	output := PaginatedOutput{
+		Pools:          pools,
+		NextBookmark:   metadata.Bookmark,
+		FetchedRecords: metadata.FetchedRecordsCount,
+	}
+	outputBytes, err := json.Marshal(output)
+	if err != nil {
+		return shim.Error("ERROR: GENERATING OUTPUT " + err.Error())
+	}
+	return shim.Success(outputBytes)
+}
+
+/* -------------------------------------------------------------------------------------------------
+ListSocialPoolStates: the SocialPoolState equivalent of ListSocialPools above. Args is an array
+             containing two strings:
+PageSize                string                        // Max pool states to return this page, parsed as int32
+Bookmark                string                        // Opaque cursor from a previous call's NextBookmark, or "" for the first page
+------------------------------------------------------------------------------------------------- */
+
+func ListSocialPoolStates(stub shim.ChaincodeStubInterface, args []string) peer.Response {
+	pageSize, bookmark, err := parsePageArgs(args)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	iterator, metadata, err := stub.GetStateByRangeWithPagination(IndexSocialPoolStates, IndexSocialPoolStates+"~", pageSize, bookmark)
+	if err != nil {
+		return shim.Error("ERROR: LISTING SOCIAL POOL STATES. " + err.Error())
+	}
+	defer iterator.Close()
+
+	poolStates := make(map[string]SocialPoolState)
+	for iterator.HasNext() {
+		kv, err := iterator.Next()
+		if err != nil {
+			return shim.Error("ERROR: LISTING SOCIAL POOL STATES. " + err.Error())
+		}
+		var poolState SocialPoolState
+		if err := json.Unmarshal(kv.Value, &poolState); err != nil {
+			return shim.Error("ERROR: PARSING SOCIAL POOL STATE " + kv.Key + ". " + err.Error())
+		}
+		poolStates[strings.TrimPrefix(kv.Key, IndexSocialPoolStates)] = poolState
+	}
+
+	output := PaginatedOutput{
+		PoolStates:     poolStates,
+		NextBookmark:   metadata.Bookmark,
+		FetchedRecords: metadata.FetchedRecordsCount,
+	}
+	outputBytes, err := json.Marshal(output)
+	if err != nil {
+		return shim.Error("ERROR: GENERATING OUTPUT " + err.Error())
+	}
+	return shim.Success(outputBytes)
+}
+
+func parsePageArgs(args []string) (int32, string, error) {
+	if err := utils.ValidateArgsLen(args, 2); err != nil {
+		return 0, "", err
+	}
+	pageSize, err := strconv.ParseInt(args[0], 10, 32)
+	if err != nil {
+		return 0, "", errors.New("ERROR: PARSING PAGE SIZE. " + err.Error())
+	}
+	return int32(pageSize), args[1], nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+GetSocialPoolsOfToken: the list-returning counterpart of GetSocialPoolOfToken, for clients that want
+             every pool matching ref (e.g. enumerating a historical TokenSymbol collision) instead of
+             an ambiguity error. limit caps how many TokenIDs are resolved to pools; 0 means no limit.
+------------------------------------------------------------------------------------------------- */
+
+func GetSocialPoolsOfToken(stub shim.ChaincodeStubInterface, ref string, limit int) ([]string, error) {
+	tokenIDs, err := resolveTokenIDs(stub, ref)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(tokenIDs) > limit {
+		tokenIDs = tokenIDs[:limit]
+	}
+	poolAddresses := make([]string, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		poolAddress, err := GetSocialPoolOfTokenID(stub, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		poolAddresses = append(poolAddresses, poolAddress)
+	}
+	return poolAddresses, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+getSocialPoolsOfToken: Invoke() entry point for GetSocialPoolsOfToken above. Args is an array
+             containing one or two strings:
+Ref                     string                        // TokenID or TokenSymbol to look up
+Limit                   string                        // Optional; max pools to return, parsed as int. Omit or "0" for no limit
+------------------------------------------------------------------------------------------------- */
+
+func getSocialPoolsOfToken(stub shim.ChaincodeStubInterface, args []string) peer.Response {
+	if err := utils.ValidateArgsLen(args, 1); err != nil {
+		return utils.Error(err)
+	}
+	limit := 0
+	if len(args) > 1 && args[1] != "" {
+		parsed, err := strconv.Atoi(args[1])
+		if err != nil {
+			return shim.Error("ERROR: PARSING LIMIT. " + err.Error())
+		}
+		limit = parsed
+	}
+	poolAddresses, err := GetSocialPoolsOfToken(stub, args[0], limit)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolAddressesBytes, err := json.Marshal(poolAddresses)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(poolAddressesBytes)
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
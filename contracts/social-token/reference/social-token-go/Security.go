@@ -0,0 +1,85 @@
+/*--------------------------------------------------------------------------
+----------------------------------------------------------------------------
+   SIGNATURE VERIFICATION AND REPLAY PROTECTION FOR SECURE INVOCATIONS
+----------------------------------------------------------------------------
+-------------------------------------------------------------------------- */
+
+package socialtoken
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Get-Cache/Privi/utils"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+/* -------------------------------------------------------------------------------------------------
+assertAndConsumeNonce: this function enforces a strictly increasing per-address nonce, keyed by the
+             address and this chaincode's name so a replayed or out-of-order secure request (e.g.
+             CreateSocialToken, ModifySocialPool, MakeBuySocialToken, MakeSellSocialToken) can never
+             be applied twice. It returns an error unless nonce is exactly one greater than the last
+             nonce recorded for address, and otherwise advances the stored nonce to nonce.
+------------------------------------------------------------------------------------------------- */
+
+func assertAndConsumeNonce(stub shim.ChaincodeStubInterface, address string, nonce int64) error {
+	key := IndexAddressNonces + address + "_" + SOCIAL_TOKEN_CHAINCODE_NAME
+	var lastNonce int64
+	data, err := stub.GetState(key)
+	if err != nil {
+		return errors.New("ERROR: GETTING NONCE OF " + address + ". " + err.Error())
+	}
+	if data != nil {
+		lastNonce, err = strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return errors.New("ERROR: PARSING NONCE OF " + address + ". " + err.Error())
+		}
+	}
+	if nonce != lastNonce+1 {
+		return errors.New("ERROR: STALE OR REPLAYED NONCE FOR " + address + ".")
+	}
+	if err := stub.PutState(key, []byte(strconv.FormatInt(nonce, 10))); err != nil {
+		return errors.New("ERROR: STORING NONCE OF " + address + ". " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+assertValidSecureRequest: this function authenticates a secure invocation's requester, chaining:
+    1. resolving requester's attached public address
+    2. verifying signature over payload was produced by that address
+    3. checking timestamp is within MAX_SIGNATURE_AGE_SECONDS of the transaction's own timestamp
+    4. consuming requester's next nonce, rejecting replays/out-of-order submissions
+It returns an error from whichever step fails first, already worded as an "ERROR: ..." message that
+names the failing step, so callers can shim.Error it directly.
+------------------------------------------------------------------------------------------------- */
+
+func assertValidSecureRequest(stub shim.ChaincodeStubInterface, requester string, nonce int64,
+	timestamp int64, hash string, signature string, payload []byte) error {
+
+	publicAddress, err := getAttachedAddress(stub, requester)
+	if err != nil {
+		return errors.New("ERROR: GETTING ADDRESS ATTACHED TO " + requester + ". " + err.Error())
+	}
+	if err := utils.VerifySignature(stub, publicAddress, hash, signature, payload); err != nil {
+		return errors.New("ERROR: VERIFYING SIGNATURE OF " + requester + ". " + err.Error())
+	}
+	txTimestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return errors.New("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+	age := int64(txTimestamp.Seconds) - timestamp
+	if age < 0 {
+		age = -age
+	}
+	if age > MAX_SIGNATURE_AGE_SECONDS {
+		return errors.New("ERROR: SIGNATURE OF " + requester + " HAS EXPIRED.")
+	}
+	if err := assertAndConsumeNonce(stub, requester, nonce); err != nil {
+		return err
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
@@ -2,8 +2,11 @@ package socialtoken
 
 import (
 	"encoding/json"
+	"errors"
+	"strconv"
 
 	"github.com/Get-Cache/Privi/contracts/coinbalance"
+	"github.com/Get-Cache/Privi/contracts/socialtoken/bridge"
 	"github.com/Get-Cache/Privi/utils"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	"github.com/hyperledger/fabric/protos/peer"
@@ -62,6 +65,21 @@ func (s *SmartContract) Invoke(stub shim.ChaincodeStubInterface) peer.Response {
 	case "getSocialTokenPriceBySymbol": // public
 		return getSocialTokenPriceBySymbol(stub, args)
 
+	case "listSocialPoolsByCreator": // public
+		return ListSocialPoolsByCreator(stub, args)
+
+	case "listSocialPoolsByTokenType": // public
+		return ListSocialPoolsByTokenType(stub, args)
+
+	case "listSocialPools": // public
+		return ListSocialPools(stub, args)
+
+	case "listSocialPoolStates": // public
+		return ListSocialPoolStates(stub, args)
+
+	case "getSocialPoolsOfToken": // public
+		return getSocialPoolsOfToken(stub, args)
+
 	case "createSocialToken": // secure
 		// check args length
 		if err := utils.ValidateArgsLen(args, 1); err != nil {
@@ -88,6 +106,32 @@ func (s *SmartContract) Invoke(stub shim.ChaincodeStubInterface) peer.Response {
 		// invoke function
 		return ModifySocialPool(stub, &input)
 
+	case "recreateSocialToken": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input RecreateSocialTokenRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return RecreateSocialToken(stub, &input)
+
+	case "changeSocialTokenOwner": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input ChangeSocialTokenOwnerRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return ChangeSocialTokenOwner(stub, &input)
+
 	case "sellSocialToken": // secure
 		// check args length
 		if err := utils.ValidateArgsLen(args, 1); err != nil {
@@ -114,6 +158,233 @@ func (s *SmartContract) Invoke(stub shim.ChaincodeStubInterface) peer.Response {
 		// invoke function
 		return MakeBuySocialToken(stub, &input)
 
+	case "haltSocialPool": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input HaltSocialPoolRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return HaltSocialPool(stub, &input)
+
+	case "resumeSocialPool": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input ResumeSocialPoolRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return ResumeSocialPool(stub, &input)
+
+	case "setPoolLock": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input SetPoolLockRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return SetPoolLock(stub, &input)
+
+	case "setGlobalLock": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input SetGlobalLockRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return SetGlobalLock(stub, &input)
+
+	case "submitSignedOp": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input SubmitSignedOpRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return SubmitSignedOp(stub, &input)
+
+	case "proposeOp": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input ProposeOpRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return ProposeOp(stub, &input)
+
+	case "coSignOp": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input CoSignOpRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return CoSignOp(stub, &input)
+
+	case "rebuildIndexes": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input RebuildIndexesRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return RebuildIndexes(stub, &input)
+
+	case "verifySocialPool": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input VerifySocialPoolRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return VerifySocialPool(stub, &input)
+
+	case "unverifySocialPool": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input VerifySocialPoolRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return UnverifySocialPool(stub, &input)
+
+	case "distributeSocialDividends": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input DistributeSocialDividendsRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return DistributeSocialDividends(stub, &input)
+
+	case "claimSocialDividend": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input ClaimSocialDividendRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return ClaimSocialDividend(stub, &input)
+
+	case "getPendingDividend": // public
+		return GetPendingDividend(stub, args)
+
+	case "bridgeOutSocialToken": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input BridgeOutSocialToken
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return MakeBridgeOutSocialToken(stub, &input)
+
+	case "bridgeInSocialToken": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input BridgeInSocialToken
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return MakeBridgeInSocialToken(stub, &input)
+
+	case "initiateBridgeOut": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input InitiateBridgeOutRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return InitiateBridgeOutSocialToken(stub, &input)
+
+	case "completeBridgeIn": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input CompleteBridgeInRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return CompleteBridgeInSocialToken(stub, &input)
+
+	case "getBridgePending": // public
+		return GetBridgePending(stub, args)
+
+	case "setChainRelayers": // secure
+		// check args length
+		if err := utils.ValidateArgsLen(args, 1); err != nil {
+			return utils.Error(err)
+		}
+		// validate signature
+		var input SetChainRelayersRequest
+		if err := json.Unmarshal([]byte(args[0]), &input); err != nil {
+			return utils.Error(err)
+		}
+		// invoke function
+		return SetChainRelayers(stub, &input)
+
 	}
 
 	return utils.NotFound(function)
@@ -137,18 +408,27 @@ Date                 decimal.Decimal               // Timestamp of the creation
 ADDITIONAL
 Hash                 string                   	   // Hash of the transaction ( args[1] )
 Signature            string                        // Signature of the transaction ( args[2] )
+Timestamp            int64                         // Time the request was signed, checked against MAX_SIGNATURE_AGE_SECONDS
+Nonce                int64                         // Creator's next sequential nonce, see assertAndConsumeNonce
 ------------------------------------------------------------------------------------------------- */
 
 func CreateSocialToken(stub shim.ChaincodeStubInterface, input *SocialPool) peer.Response {
 	updateSocialPools := make(map[string]SocialPool)
 	updateSocialPoolStates := make(map[string]SocialPoolState)
 
-	// // Verify signature address //
-	// var publicAddress string
-	// publicAddress, err := getAttachedAddress(stub, input.Creator)
-	// if err != nil {
-	// 	return shim.Error(err.Error())
-	// }
+	if !stringInSlice(input.AMM, AMM_TYPES) {
+		return shim.Error("ERROR: UNSUPPORTED AMM CURVE " + input.AMM + ".")
+	}
+
+	// Verify signature address //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Creator, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
 
 	// Get Transaction Date //
 	timestamp, err := stub.GetTxTimestamp()
@@ -171,6 +451,18 @@ func CreateSocialToken(stub shim.ChaincodeStubInterface, input *SocialPool) peer
 		return shim.Error(err.Error())
 	}
 
+	// Assign the permanent TokenID identity and index it, independently of TokenSymbol //
+	input.TokenID, err = nextTokenID(stub)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := putTokenIDPool(stub, input.TokenID, input.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := addTokenIDToSymbolIndex(stub, input.TokenSymbol, input.TokenID); err != nil {
+		return shim.Error(err.Error())
+	}
+
 	// Register social token and mint initial supply to user //
 	updateTokens, transactions, err := registerSocialToken(stub, *input, input.Creator)
 	if err != nil {
@@ -195,9 +487,13 @@ func CreateSocialToken(stub shim.ChaincodeStubInterface, input *SocialPool) peer
 	}
 	updateSocialPoolStates[input.PoolAddress] = poolState
 
+	// A freshly created pool is never pre-verified; the curator endorses it later via
+	// verifySocialPool //
+	verifiedPools := map[string]bool{input.PoolAddress: false}
+
 	// Generate output //
 	return generateOutput(updateSocialPools, updateSocialPoolStates,
-		updateTokens, transactions)
+		updateTokens, transactions, verifiedPools)
 }
 
 /* -------------------------------------------------------------------------------------------------
@@ -212,16 +508,541 @@ func ModifySocialPool(stub shim.ChaincodeStubInterface, input *ModifySocialPoolR
 	if err != nil {
 		return shim.Error(err.Error())
 	}
+	if err := assertPoolAdmin(stub, pool, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Track whether this request touches a curve invariant a curator relied on when endorsing
+	// the pool, so verification can be revoked rather than silently carried over //
+	revokesVerification := false
+
+	// Fetched lazily: trading history is checked before the AMM curve of any pool changes, and
+	// before TargetPrice/TargetSupply change on CONSTANT_PRODUCT_AMM/LMSR_AMM pools only (where
+	// those fields are the curve's own x0/y0 or liquidity parameters, not just a price target) //
+	var poolState SocialPoolState
+	var poolStateLoaded bool
+	assertNoTradingHistory := func(reason string) error {
+		if !poolStateLoaded {
+			var err error
+			poolState, err = GetSocialPoolState(stub, pool.PoolAddress)
+			if err != nil {
+				return err
+			}
+			poolStateLoaded = true
+		}
+		if effectiveSupplyReleased(poolState).GreaterThan(pool.InitialSupply) {
+			return errors.New("ERROR: CANNOT " + reason + " OF POOL " + pool.PoolAddress + " AFTER TOKENS HAVE BEEN TRADED.")
+		}
+		return nil
+	}
+
+	if input.FundingToken != "" && input.FundingToken != pool.FundingToken {
+		pool.FundingToken = input.FundingToken
+		revokesVerification = true
+	}
+	if input.AMM != "" && input.AMM != pool.AMM {
+		if !stringInSlice(input.AMM, AMM_TYPES) {
+			return shim.Error("ERROR: UNSUPPORTED AMM CURVE " + input.AMM + ".")
+		}
+		// Switching curves after tokens have already been sold off the old one would jump the
+		// price discontinuously for every existing holder, so it is only allowed before the
+		// pool has any trading history //
+		if err := assertNoTradingHistory("CHANGE AMM CURVE"); err != nil {
+			return shim.Error(err.Error())
+		}
+		oldAMM := pool.AMM
+		pool.AMM = input.AMM
+		if err := removePoolFromIndex(stub, IndexAMMTypePools, oldAMM, pool.PoolAddress); err != nil {
+			return shim.Error(err.Error())
+		}
+		if err := addPoolToIndex(stub, IndexAMMTypePools, pool.AMM, pool.PoolAddress); err != nil {
+			return shim.Error(err.Error())
+		}
+		revokesVerification = true
+	}
+	if !input.TargetPrice.IsZero() && !input.TargetPrice.Equal(pool.TargetPrice) {
+		// For CONSTANT_PRODUCT/LMSR pools, TargetPrice is not a price target but a curve parameter
+		// (y0, or the LMSR scale factor) per those curves' own doc comments, so changing it after
+		// trading has started would discontinuously reprice the pool for every existing holder //
+		if isParameterCurve(pool.AMM) {
+			if err := assertNoTradingHistory("CHANGE TargetPrice"); err != nil {
+				return shim.Error(err.Error())
+			}
+		}
+		pool.TargetPrice = input.TargetPrice
+		revokesVerification = true
+	}
+	if !input.TargetSupply.IsZero() && !input.TargetSupply.Equal(pool.TargetSupply) {
+		// Same reasoning as TargetPrice above: TargetSupply is repurposed as x0 or the LMSR
+		// liquidity parameter b for these curves //
+		if isParameterCurve(pool.AMM) {
+			if err := assertNoTradingHistory("CHANGE TargetSupply"); err != nil {
+				return shim.Error(err.Error())
+			}
+		}
+		pool.TargetSupply = input.TargetSupply
+		revokesVerification = true
+	}
+
+	if revokesVerification && pool.Verified {
+		if err := setPoolVerified(stub, pool.PoolAddress, false); err != nil {
+			return shim.Error(err.Error())
+		}
+		pool.Verified = false
+	}
+
+	// Register new Social Pool //
+	err = updateSocialPoolInfo(stub, pool)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Generate output //
+	updateSocialPools := map[string]SocialPool{pool.PoolAddress: pool}
+	verifiedPools := map[string]bool{pool.PoolAddress: pool.Verified}
+	return generateOutput(updateSocialPools, nil, nil, nil, verifiedPools)
+}
+
+/* -------------------------------------------------------------------------------------------------
+recreateSocialToken: this function retires OldPoolAddress and creates a brand new pool that inherits
+             its TokenID lineage, so a renamed or re-parameterized pool keeps the identity clients,
+             indexers, and dividend history already know it by. The old pool must already be halted
+             (see haltSocialPool) before it is eligible, so a still-trading pool cannot be orphaned
+             out from under its holders.
+------------------------------------------------------------------------------------------------- */
+
+func RecreateSocialToken(stub shim.ChaincodeStubInterface, input *RecreateSocialTokenRequest) peer.Response {
+
+	oldPool, err := GetSocialPoolInfo(stub, input.OldPoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolAdmin(stub, oldPool, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+	if oldPool.SupersededBy != "" {
+		return shim.Error("ERROR: POOL " + oldPool.PoolAddress + " HAS ALREADY BEEN RECREATED AS " + oldPool.SupersededBy + ".")
+	}
+	oldPoolState, err := GetSocialPoolState(stub, input.OldPoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolActive(stub, oldPoolState); err == nil {
+		return shim.Error("ERROR: POOL " + oldPool.PoolAddress + " MUST BE HALTED BEFORE IT CAN BE RECREATED.")
+	}
+	if !stringInSlice(input.AMM, AMM_TYPES) {
+		return shim.Error("ERROR: UNSUPPORTED AMM CURVE " + input.AMM + ".")
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	timestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+	date := int64(timestamp.Seconds)
+
+	newPool := SocialPool{
+		Creator:        input.Requester,
+		AMM:            input.AMM,
+		TokenID:        oldPool.TokenID,
+		SpreadDividend: input.SpreadDividend,
+		TokenSymbol:    input.TokenSymbol,
+		TokenName:      input.TokenName,
+		InitialSupply:  input.InitialSupply,
+		FundingToken:   input.FundingToken,
+		DividendFreq:   input.DividendFreq,
+		LockUpDate:     input.LockUpDate,
+		TargetSupply:   input.TargetSupply,
+		TargetPrice:    input.TargetPrice,
+		TokenChain:     input.TokenChain,
+		Date:           date,
+	}
+
+	newPoolBytes, err := json.Marshal(newPool)
+	if err != nil {
+		return utils.Error(err)
+	}
+	newPool.PoolAddress = getUniqueAddress(newPoolBytes, date)
+	if err := coinbalance.RegisterAddress(stub, newPool.PoolAddress, coinbalance.SocialTokenAddressType); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Repoint the TokenID lineage at the new pool, index its symbol, and retire the old pool //
+	if err := putTokenIDPool(stub, newPool.TokenID, newPool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := addTokenIDToSymbolIndex(stub, newPool.TokenSymbol, newPool.TokenID); err != nil {
+		return shim.Error(err.Error())
+	}
+	oldPool.SupersededBy = newPool.PoolAddress
+	if err := updateSocialPoolInfo(stub, oldPool); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Register social token and mint initial supply to the new creator //
+	updateTokens, transactions, err := registerSocialToken(stub, newPool, newPool.Creator)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := updateSocialPoolInfo(stub, newPool); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState := SocialPoolState{
+		SupplyReleased: newPool.InitialSupply,
+		DividendFunds:  decimal.Zero,
+	}
+	if err := updateSocialTokenState(stub, poolState, newPool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPools := map[string]SocialPool{
+		oldPool.PoolAddress: oldPool,
+		newPool.PoolAddress: newPool,
+	}
+	updateSocialPoolStates := map[string]SocialPoolState{newPool.PoolAddress: poolState}
+	verifiedPools := map[string]bool{newPool.PoolAddress: false}
+	return generateOutput(updateSocialPools, updateSocialPoolStates, updateTokens, transactions, verifiedPools)
+}
+
+/* -------------------------------------------------------------------------------------------------
+changeSocialTokenOwner: this function transfers a pool's Creator rights (halt/resume/modify/recreate
+             authority) to a new address, e.g. when a community hands off stewardship of its token.
+------------------------------------------------------------------------------------------------- */
+
+func ChangeSocialTokenOwner(stub shim.ChaincodeStubInterface, input *ChangeSocialTokenOwnerRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolAdmin(stub, pool, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	oldCreator := pool.Creator
+	pool.Creator = input.NewOwner
+	if err := updateSocialPoolInfo(stub, pool); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := removePoolFromIndex(stub, IndexCreatorPools, oldCreator, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := addPoolToIndex(stub, IndexCreatorPools, pool.Creator, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPools := map[string]SocialPool{pool.PoolAddress: pool}
+	return generateOutput(updateSocialPools, nil, nil, nil, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+verifySocialPool / unverifySocialPool: ADMIN_ROLE curates IndexVerifiedSocialPools so clients can
+             tell curator-endorsed social tokens from arbitrary user-created ones. Args is an array
+             containing one json with:
+Requester               string  			  	       // Id of the admin making the request
+PoolAddress             string  			  	       // Address of the social pool
+Hash                    string                        // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func VerifySocialPool(stub shim.ChaincodeStubInterface, input *VerifySocialPoolRequest) peer.Response {
+	if err := assertAdmin(stub, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if _, err := GetSocialPoolInfo(stub, input.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := setPoolVerified(stub, input.PoolAddress, true); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	verifiedPools := map[string]bool{input.PoolAddress: true}
+	return generateOutput(nil, nil, nil, nil, verifiedPools)
+}
+
+func UnverifySocialPool(stub shim.ChaincodeStubInterface, input *VerifySocialPoolRequest) peer.Response {
+	if err := assertAdmin(stub, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if _, err := GetSocialPoolInfo(stub, input.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := setPoolVerified(stub, input.PoolAddress, false); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	verifiedPools := map[string]bool{input.PoolAddress: false}
+	return generateOutput(nil, nil, nil, nil, verifiedPools)
+}
+
+/* -------------------------------------------------------------------------------------------------
+haltSocialPool: this function lets the pool Creator or ADMIN_ROLE stop buying/selling against a
+             SocialPool without destroying it, for incident response (bad oracle price,
+             compromised creator key, legal takedown). Args is an array containing one json with:
+Requester               string                   	   // Id of whoever is requesting the halt
+PoolAddress             string  			  	       // Address of the social pool
+Duration                int64                   	   // Seconds until auto-resume, 0 = indefinite
+Hash                    string                   	   // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func HaltSocialPool(stub shim.ChaincodeStubInterface, input *HaltSocialPoolRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolAdmin(stub, pool, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState.Halted = true
+	poolState.HaltUntil = 0
+	if input.Duration > 0 {
+		timestamp, err := stub.GetTxTimestamp()
+		if err != nil {
+			return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+		}
+		poolState.HaltUntil = int64(timestamp.Seconds) + input.Duration
+	}
+
+	if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+	return generateOutput(nil, updateSocialPoolStates, nil, nil, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+resumeSocialPool: this function lets the pool Creator or ADMIN_ROLE lift a halt placed by
+             haltSocialPool. Args is an array containing one json with:
+Requester               string                   	   // Id of whoever is requesting the resume
+PoolAddress             string  			  	       // Address of the social pool
+Hash                    string                   	   // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func ResumeSocialPool(stub shim.ChaincodeStubInterface, input *ResumeSocialPoolRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolAdmin(stub, pool, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState.Halted = false
+	poolState.HaltUntil = 0
+
+	if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+	return generateOutput(nil, updateSocialPoolStates, nil, nil, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+setPoolLock: this function lets the pool Creator or ADMIN_ROLE place or lift an emergency lock on a
+             single SocialPool, stricter than haltSocialPool: a locked pool also blocks
+             multiTransfer/mintSocialPoolTokens/burnSocialPoolTokens (dividends, bridgeOut/bridgeIn),
+             not just buying/selling, except for the function names listed in AllowedWhileLocked.
+             The lockbox initiateBridgeOut/completeBridgeIn flow calls coinbalance directly and is
+             not blocked by the lock. Args is an array containing one json with:
+Requester               string                   	   // Id of whoever is requesting the lock change
+PoolAddress             string  			  	       // Address of the social pool
+Locked                  bool                          // true to lock, false to clear the lock
+Reason                  string                        // Free-text audit note, persisted and emitted
+AllowedWhileLocked      []string                      // Function names exempt from the lock (e.g. "sellSocialToken" for redemptions). Only applied when Locked is true
+Hash                    string                        // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func SetPoolLock(stub shim.ChaincodeStubInterface, input *SetPoolLockRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolAdmin(stub, pool, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	timestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+
+	poolState.Locked = input.Locked
+	if input.Locked {
+		poolState.LockedBy = input.Requester
+		poolState.LockedAt = int64(timestamp.Seconds)
+		poolState.Reason = input.Reason
+		poolState.AllowedWhileLocked = input.AllowedWhileLocked
+	} else {
+		poolState.LockedBy = ""
+		poolState.LockedAt = 0
+		poolState.Reason = ""
+		poolState.AllowedWhileLocked = nil
+	}
+
+	if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := emitPoolLockEvent(stub, pool.PoolAddress, poolState, int64(timestamp.Seconds)); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+	return generateOutput(nil, updateSocialPoolStates, nil, nil, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+setGlobalLock: this function lets ADMIN_ROLE place or lift a chain-wide emergency lock that blocks
+             every SocialPool, with no per-function allowlist (use setPoolLock for a narrower,
+             per-pool incident). Args is an array containing one json with:
+Requester               string                   	   // Id of the admin requesting the lock change
+Locked                  bool                          // true to lock, false to clear the lock
+Reason                  string                        // Free-text audit note, emitted on the event
+Hash                    string                        // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func SetGlobalLock(stub shim.ChaincodeStubInterface, input *SetGlobalLockRequest) peer.Response {
+	if err := assertAdmin(stub, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
 
-	pool.FundingToken = input.FundingToken
-
-	// Register new Social Pool //
-	err = updateSocialPoolInfo(stub, pool)
+	timestamp, err := stub.GetTxTimestamp()
 	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+	if err := setGlobalLocked(stub, input.Locked); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := emitGlobalLockEvent(stub, input.Locked, input.Requester, input.Reason, int64(timestamp.Seconds)); err != nil {
 		return shim.Error(err.Error())
 	}
 
-	// Generate output //
 	return shim.Success(nil)
 }
 
@@ -232,22 +1053,27 @@ PoolAddress       		string  			  	    // Address of the social pool
 Amount                  decimal.Decimal             // Amount of social tokens to sell
 Hash                    string                   	// Hash of the transaction ( args[2] )
 Signature               string                      // Signature of the transaction ( args[3] )
+MinFundingOut           decimal.Decimal             // Aborts if the curve would pay out less than this. Zero means no floor
+Deadline                int64                       // Aborts if the tx timestamp is past this. Zero means no cutoff
 ------------------------------------------------------------------------------------------------- */
 
 func MakeSellSocialToken(stub shim.ChaincodeStubInterface, input *SellSocialToken) peer.Response {
 
 	updateSocialPoolStates := make(map[string]SocialPoolState)
 
-	// // Verify signature //
-	// var publicAddress string
-	// publicAddress, err := getAttachedAddress(stub, input.Investor)
-	// if err != nil {
-	// 	return shim.Error(err.Error())
-	// }
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Investor, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
 
 	// Retrieve pod info and pod state //
 	var pool SocialPool
-	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	pool, err = GetSocialPoolInfo(stub, input.PoolAddress)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -256,13 +1082,29 @@ func MakeSellSocialToken(stub shim.ChaincodeStubInterface, input *SellSocialToke
 	if err != nil {
 		return shim.Error(err.Error())
 	}
+	if err := assertPoolActive(stub, poolState); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolUnlocked(stub, poolState, input.PoolAddress, "sellSocialToken"); err != nil {
+		return shim.Error(err.Error())
+	}
+	txTimestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+	if input.Deadline > 0 && int64(txTimestamp.Seconds) > input.Deadline {
+		return shim.Error("ERROR: SELL DEADLINE FOR POOL " + input.PoolAddress + " HAS PASSED.")
+	}
 
 	// Get the amount of funding token what we need to receive given amount of social tokens //
-	fundingAmount, err := selling_social_tokens(pool.AMM, poolState.SupplyReleased,
+	fundingAmount, err := selling_social_tokens(pool.AMM, effectiveSupplyReleased(poolState),
 		pool.InitialSupply, input.Amount, pool.SpreadDividend, pool.TargetPrice, pool.TargetSupply)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
+	if !input.MinFundingOut.IsZero() && fundingAmount.LessThan(input.MinFundingOut) {
+		return shim.Error("ERROR: SELL OF POOL " + input.PoolAddress + " WOULD RETURN LESS THAN MinFundingOut.")
+	}
 	poolState.SupplyReleased, err = saveSubstraction(poolState.SupplyReleased, input.Amount)
 	if err != nil {
 		return shim.Error(err.Error())
@@ -276,7 +1118,7 @@ func MakeSellSocialToken(stub shim.ChaincodeStubInterface, input *SellSocialToke
 		From:   input.Investor,
 		To:     pool.PoolAddress,
 	}
-	transactions, err := burnSocialPoolTokens(stub, &burningSocialToken)
+	transactions, err := burnSocialPoolTokens(stub, poolState, pool.PoolAddress, "sellSocialToken", &burningSocialToken)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -290,7 +1132,7 @@ func MakeSellSocialToken(stub shim.ChaincodeStubInterface, input *SellSocialToke
 		To:     input.Investor,
 	}
 
-	transactions2, err := multiTransfer(stub, sellingTransfer)
+	transactions2, err := multiTransfer(stub, poolState, pool.PoolAddress, "sellSocialToken", sellingTransfer)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -303,8 +1145,13 @@ func MakeSellSocialToken(stub shim.ChaincodeStubInterface, input *SellSocialToke
 	}
 	updateSocialPoolStates[pool.PoolAddress] = poolState
 
+	// Emit trade event so off-chain indexers can reconstruct trade history without re-reading state //
+	if err := emitTradeEvent(stub, pool, poolState, input.Investor, SELL_SIDE, input.Amount, fundingAmount, int64(txTimestamp.Seconds)); err != nil {
+		return shim.Error(err.Error())
+	}
+
 	// Generate output //
-	return generateOutput(nil, updateSocialPoolStates, nil, transactions)
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
 }
 
 /* -------------------------------------------------------------------------------------------------
@@ -314,21 +1161,26 @@ PoolAddress          string  			           // Address of the social pool
 Amount               decimal.Decimal               // Amount of social tokens to buy
 Hash                 string                   	   // Hash of the transaction
 Signature            string                        // Signature of the transaction
+MaxFundingIn         decimal.Decimal               // Aborts if the curve would cost more than this. Zero means no cap
+Deadline             int64                         // Aborts if the tx timestamp is past this. Zero means no cutoff
 ------------------------------------------------------------------------------------------------- */
 
 func MakeBuySocialToken(stub shim.ChaincodeStubInterface, input *BuySocialToken) peer.Response {
 	updateSocialPoolStates := make(map[string]SocialPoolState)
 
 	// Verify signature //
-	// var publicAddress string
-	// publicAddress, err := getAttachedAddress(stub, input.Investor)
-	// if err != nil {
-	// 	return shim.Error(err.Error())
-	// }
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Investor, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
 
 	// Retrieve pod info and pod state //
 	var pool SocialPool
-	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	pool, err = GetSocialPoolInfo(stub, input.PoolAddress)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -337,13 +1189,29 @@ func MakeBuySocialToken(stub shim.ChaincodeStubInterface, input *BuySocialToken)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
+	if err := assertPoolActive(stub, poolState); err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolUnlocked(stub, poolState, input.PoolAddress, "buySocialToken"); err != nil {
+		return shim.Error(err.Error())
+	}
+	txTimestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+	if input.Deadline > 0 && int64(txTimestamp.Seconds) > input.Deadline {
+		return shim.Error("ERROR: BUY DEADLINE FOR POOL " + input.PoolAddress + " HAS PASSED.")
+	}
 
 	// Get the amount of funding token what we need to buy given amount of social tokens //
-	fundingAmount, err := buyingSocialTokens(pool.AMM, poolState.SupplyReleased,
+	fundingAmount, err := buyingSocialTokens(pool.AMM, effectiveSupplyReleased(poolState),
 		pool.InitialSupply, input.Amount, pool.TargetPrice, pool.TargetSupply)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
+	if !input.MaxFundingIn.IsZero() && fundingAmount.GreaterThan(input.MaxFundingIn) {
+		return shim.Error("ERROR: BUY OF POOL " + input.PoolAddress + " WOULD COST MORE THAN MaxFundingIn.")
+	}
 	poolState.SupplyReleased = poolState.SupplyReleased.Add(input.Amount)
 	poolState.DividendFunds = poolState.DividendFunds.Add(fundingAmount.Mul(pool.SpreadDividend))
 
@@ -356,7 +1224,7 @@ func MakeBuySocialToken(stub shim.ChaincodeStubInterface, input *BuySocialToken)
 		To:     pool.PoolAddress,
 	}
 
-	transactions, err := multiTransfer(stub, buyingTransfer)
+	transactions, err := multiTransfer(stub, poolState, pool.PoolAddress, "buySocialToken", buyingTransfer)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -369,7 +1237,7 @@ func MakeBuySocialToken(stub shim.ChaincodeStubInterface, input *BuySocialToken)
 		From:   pool.PoolAddress,
 		To:     input.Investor,
 	}
-	transactions2, err := mintSocialPoolTokens(stub, &mintingSocialToken)
+	transactions2, err := mintSocialPoolTokens(stub, poolState, pool.PoolAddress, "buySocialToken", &mintingSocialToken)
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -382,9 +1250,335 @@ func MakeBuySocialToken(stub shim.ChaincodeStubInterface, input *BuySocialToken)
 	}
 	updateSocialPoolStates[pool.PoolAddress] = poolState
 
+	// Emit trade event so off-chain indexers can reconstruct trade history without re-reading state //
+	if err := emitTradeEvent(stub, pool, poolState, input.Investor, BUY_SIDE, input.Amount, fundingAmount, int64(txTimestamp.Seconds)); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Generate output //
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
+
+}
+
+/* -------------------------------------------------------------------------------------------------
+bridgeOutSocialToken: this function is called when an Investor wants to move social tokens off this
+             chain onto DestinationChain. It burns the tokens here and escrows the funding-token
+             value the bonding curve currently assigns to that supply slice, so the tokens can be
+             re-hydrated 1:1 on bridge-in without re-charging the curve.
+Investor                string               		    // Id of the investor
+PoolAddress             string  			  	        // Address of the social pool
+Amount                  decimal.Decimal                // Amount of social tokens to bridge out
+DestinationChain        string                         // TokenChain value the tokens are moving to
+DestinationAddress      string                         // Recipient address on the destination chain
+Hash                    string                   	    // Hash of the transaction ( args[2] )
+Signature               string                         // Signature of the transaction ( args[3] )
+Timestamp               int64                          // Timestamp the Investor signed over
+Nonce                   int64                          // Investor's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func MakeBridgeOutSocialToken(stub shim.ChaincodeStubInterface, input *BridgeOutSocialToken) peer.Response {
+
+	updateSocialPoolStates := make(map[string]SocialPoolState)
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Investor, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Retrieve pod info and pod state //
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolActive(stub, poolState); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Value the supply slice being bridged out at the curve's current price, so bridging out
+	// never pays or costs more than selling the same slice would. No spread is charged: the
+	// tokens are not leaving circulation, just this chain //
+	escrowedFunding, err := selling_social_tokens(pool.AMM, effectiveSupplyReleased(poolState),
+		pool.InitialSupply, input.Amount, decimal.Zero, pool.TargetPrice, pool.TargetSupply)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Burn the bridged tokens on this chain //
+	burningSocialToken := coinbalance.TransferRequest{
+		Type:   "Social_Token_Bridge_Out_Burning",
+		Token:  pool.TokenSymbol,
+		Amount: input.Amount,
+		From:   input.Investor,
+		To:     pool.PoolAddress,
+	}
+	transactions, err := burnSocialPoolTokens(stub, poolState, pool.PoolAddress, "bridgeOutSocialToken", &burningSocialToken)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Record the escrowed funding-token value as a pool-to-pool transfer, so it shows up in the
+	// audit trail without actually moving the pool's balance //
+	escrowTransfer := coinbalance.TransferRequest{
+		Type:   "Social_Token_Bridge_Out_Escrow",
+		Token:  pool.FundingToken,
+		Amount: escrowedFunding,
+		From:   pool.PoolAddress,
+		To:     pool.PoolAddress,
+	}
+	escrowTransactions, err := multiTransfer(stub, poolState, pool.PoolAddress, "bridgeOutSocialToken", escrowTransfer)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	transactions = append(transactions, escrowTransactions[:]...)
+
+	poolState.SupplyReleased, err = saveSubstraction(poolState.SupplyReleased, input.Amount)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState.BridgedSupply = poolState.BridgedSupply.Add(input.Amount)
+
+	// Update pool state //
+	err = updateSocialTokenState(stub, poolState, pool.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	updateSocialPoolStates[pool.PoolAddress] = poolState
+
+	// Generate output //
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+bridgeInSocialToken: this function is called to re-hydrate social tokens that were previously
+             bridged out, once a quorum of EXCHANGE_ROLE relayers has attested to the transfer on
+             SourceChain. Minting here does not charge the curve: the supply already paid for
+             itself on bridge-out and BridgedSupply is what kept the curve price steady meanwhile.
+Investor                string               		    // Id of the investor receiving the tokens
+PoolAddress             string  			  	        // Address of the social pool
+Amount                  decimal.Decimal                // Amount of social tokens to bridge in
+SourceChain             string                         // TokenChain value the tokens moved from
+SourceAddress           string                         // Sender address on the source chain
+Hash                    string                   	    // Hash attested to by the relayers
+Relayers                []string                       // Attesting relayer ids
+Signatures              []string                       // Relayers[i]'s signature over Hash
+------------------------------------------------------------------------------------------------- */
+
+func MakeBridgeInSocialToken(stub shim.ChaincodeStubInterface, input *BridgeInSocialToken) peer.Response {
+
+	updateSocialPoolStates := make(map[string]SocialPoolState)
+
+	// Retrieve pod info and pod state //
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolActive(stub, poolState); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := verifyRelayerQuorum(stub, input.Relayers, input.Signatures, BRIDGE_RELAYER_THRESHOLD,
+		input.Hash, payload); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState.BridgedSupply, err = saveSubstraction(poolState.BridgedSupply, input.Amount)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState.SupplyReleased = poolState.SupplyReleased.Add(input.Amount)
+
+	// Mint the re-hydrated tokens to the investor, at no curve cost //
+	mintingSocialToken := coinbalance.TransferRequest{
+		Type:   "Social_Token_Bridge_In_Minting",
+		Token:  pool.TokenSymbol,
+		Amount: input.Amount,
+		From:   pool.PoolAddress,
+		To:     input.Investor,
+	}
+	transactions, err := mintSocialPoolTokens(stub, poolState, pool.PoolAddress, "bridgeInSocialToken", &mintingSocialToken)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Update pool state //
+	err = updateSocialTokenState(stub, poolState, pool.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	updateSocialPoolStates[pool.PoolAddress] = poolState
+
 	// Generate output //
-	return generateOutput(nil, updateSocialPoolStates, nil, transactions)
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
+}
+
+/* -------------------------------------------------------------------------------------------------
+initiateBridgeOut / completeBridgeIn / getBridgePending / setChainRelayers: lockbox bridge to an
+             external chain via the socialtoken/bridge package. Unlike
+             MakeBridgeOutSocialToken/MakeBridgeInSocialToken above (whose bridge-in accepts
+             attestations from any EXCHANGE_ROLE users against the fixed BRIDGE_RELAYER_THRESHOLD), this flow checks
+             attestations against a per-chain relayer set and M-of-N threshold stored by
+             setChainRelayers, and completes each (sourceChain, sourceTx) bridge-in only once.
+------------------------------------------------------------------------------------------------- */
+
+func InitiateBridgeOutSocialToken(stub shim.ChaincodeStubInterface, input *InitiateBridgeOutRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolActive(stub, poolState); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	timestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return shim.Error("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+	}
+
+	_, transactions, err := bridge.InitiateBridgeOut(stub, pool.PoolAddress, pool.TokenSymbol,
+		input.Requester, input.Amount, input.DestinationChain, input.DestinationAddress, int64(timestamp.Seconds))
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Mirror MakeBridgeOutSocialToken's accounting: the tokens are not leaving circulation for
+	// good, just this chain, so the curve's effective supply is preserved via BridgedSupply //
+	poolState.SupplyReleased, err = saveSubstraction(poolState.SupplyReleased, input.Amount)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState.BridgedSupply = poolState.BridgedSupply.Add(input.Amount)
+	if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
 
+	updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
+}
+
+func CompleteBridgeInSocialToken(stub shim.ChaincodeStubInterface, input *CompleteBridgeInRequest) peer.Response {
+
+	pool, err := GetSocialPoolInfo(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	poolState, err := GetSocialPoolState(stub, input.PoolAddress)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := assertPoolActive(stub, poolState); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	transactions, err := bridge.CompleteBridgeIn(stub, pool.PoolAddress, pool.TokenSymbol,
+		input.SourceChain, input.SourceTx, input.Hash, input.Relayers, input.Signatures,
+		input.Recipient, input.Amount, payload)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+
+	poolState.SupplyReleased = poolState.SupplyReleased.Add(input.Amount)
+	poolState.BridgedSupply, err = saveSubstraction(poolState.BridgedSupply, input.Amount)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if err := updateSocialTokenState(stub, poolState, pool.PoolAddress); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	updateSocialPoolStates := map[string]SocialPoolState{pool.PoolAddress: poolState}
+	return generateOutput(nil, updateSocialPoolStates, nil, transactions, nil)
+}
+
+func GetBridgePending(stub shim.ChaincodeStubInterface, args []string) peer.Response {
+	nonce, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return shim.Error("ERROR: PARSING NONCE. " + err.Error())
+	}
+	record, err := bridge.GetBridgePending(stub, args[0], nonce)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(recordBytes)
+}
+
+/* -------------------------------------------------------------------------------------------------
+setChainRelayers: this function lets ADMIN_ROLE authorize the relayer set and attestation threshold
+             trusted to approve bridge-ins for a given external chain. Args is an array containing
+             one json with:
+Requester               string  			  	       // Id of the admin making the request
+Chain                   string                        // External chain identifier this relayer set is trusted for
+Relayers                []string                      // EXCHANGE_ROLE addresses authorized to attest bridge-ins on Chain
+Threshold               int                           // Minimum distinct relayer attestations required
+Hash                    string                        // Hash of the transaction
+Signature               string                        // Signature of the transaction
+Timestamp               int64                         // Timestamp the Requester signed over
+Nonce                   int64                         // Requester's next nonce
+------------------------------------------------------------------------------------------------- */
+
+func SetChainRelayers(stub shim.ChaincodeStubInterface, input *SetChainRelayersRequest) peer.Response {
+	if err := assertAdmin(stub, input.Requester); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	// Verify signature //
+	requestBytes, err := json.Marshal(input)
+	if err != nil {
+		return utils.Error(err)
+	}
+	if err := assertValidSecureRequest(stub, input.Requester, input.Nonce, input.Timestamp,
+		input.Hash, input.Signature, requestBytes); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	if err := bridge.SetChainRelayers(stub, bridge.ChainRelayerConfig{
+		Chain:     input.Chain,
+		Relayers:  input.Relayers,
+		Threshold: input.Threshold,
+	}); err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(nil)
 }
 
 /* -------------------------------------------------------------------------------------------------
@@ -407,7 +1601,7 @@ func GetSocialTokenPrice(stub shim.ChaincodeStubInterface,
 	}
 
 	// Get market price //
-	marketPrice, err := getMarketPrice(pool.AMM, poolState.SupplyReleased, pool.InitialSupply,
+	marketPrice, err := getMarketPrice(pool.AMM, effectiveSupplyReleased(poolState), pool.InitialSupply,
 		pool.TargetPrice, pool.TargetSupply)
 	if err != nil {
 		return shim.Error(err.Error())
@@ -421,14 +1615,32 @@ func GetSocialTokenPrice(stub shim.ChaincodeStubInterface,
 }
 
 /* -------------------------------------------------------------------------------------------------
-getSocialTokenPriceBySymbol: this function is called to get the market price of a pod token
-TokenSymbol              string  			  // Symbol of the social token
+getSocialTokenPriceBySymbol: this function is called to get the market price of a pod token, looked
+             up by either its TokenID or its TokenSymbol. Since a symbol is display-only and may be
+             shared by several pools, a symbol matching more than one TokenID returns a
+             TokenIDAmbiguous listing the candidates instead of a price.
+args[0]                  string  			  // TokenID or TokenSymbol of the social token
 ------------------------------------------------------------------------------------------------- */
 
 func getSocialTokenPriceBySymbol(stub shim.ChaincodeStubInterface,
 	args []string) peer.Response {
 
-	poolAddress, err := GetSocialPoolOfToken(stub, args[0])
+	tokenIDs, err := resolveTokenIDs(stub, args[0])
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	if len(tokenIDs) == 0 {
+		return shim.Error("ERROR: NO SOCIAL TOKEN FOUND FOR " + args[0] + ".")
+	}
+	if len(tokenIDs) > 1 {
+		res, err := json.Marshal(TokenIDAmbiguous{Symbol: args[0], TokenIDs: tokenIDs})
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		return shim.Success(res)
+	}
+
+	poolAddress, err := GetSocialPoolOfTokenID(stub, tokenIDs[0])
 	if err != nil {
 		return shim.Error(err.Error())
 	}
@@ -445,7 +1657,7 @@ func getSocialTokenPriceBySymbol(stub shim.ChaincodeStubInterface,
 	}
 
 	// Get market price //
-	marketPrice, err := getMarketPrice(pool.AMM, poolState.SupplyReleased, pool.InitialSupply,
+	marketPrice, err := getMarketPrice(pool.AMM, effectiveSupplyReleased(poolState), pool.InitialSupply,
 		pool.TargetPrice, pool.TargetSupply)
 	if err != nil {
 		return shim.Error(err.Error())
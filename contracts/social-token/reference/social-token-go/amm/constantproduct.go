@@ -0,0 +1,63 @@
+package amm
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+ConstantProductCurve prices social tokens against a Uniswap-style virtual x*y=k pool instead of a
+closed-form curve. TargetSupply is repurposed as x0, the virtual token reserve at zero supply
+released, and TargetPrice is repurposed as y0, the virtual FundingToken reserve at zero supply
+released; k = x0*y0 is held constant. The pool's real token/FundingToken balances still move
+through the usual mint/burn/transfer calls in socialtoken.go — x0/y0 only describe the shape of
+the curve, they are not a separate reserve socialtoken.go needs to fund or track.
+------------------------------------------------------------------------------------------------- */
+
+type ConstantProductCurve struct{}
+
+func (ConstantProductCurve) virtualTokenReserve(supplyReleased, initialSupply, x0 decimal.Decimal) decimal.Decimal {
+	effectiveSupply := decimal.Max(decimal.Zero, supplyReleased.Sub(initialSupply))
+	return x0.Sub(effectiveSupply)
+}
+
+func (c ConstantProductCurve) Buy(supplyReleased, initialSupply, amount, x0, y0 decimal.Decimal) (decimal.Decimal, error) {
+	if !x0.IsPositive() || !y0.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: CONSTANT_PRODUCT CURVE REQUIRES POSITIVE x0/y0.")
+	}
+	k := x0.Mul(y0)
+	xBefore := c.virtualTokenReserve(supplyReleased, initialSupply, x0)
+	xAfter := xBefore.Sub(amount)
+	if !xAfter.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: CONSTANT_PRODUCT CURVE CANNOT SELL MORE THAN ITS VIRTUAL TOKEN RESERVE.")
+	}
+	return k.Div(xAfter).Sub(k.Div(xBefore)), nil
+}
+
+func (c ConstantProductCurve) Sell(supplyReleased, initialSupply, amount, spread, x0, y0 decimal.Decimal) (decimal.Decimal, error) {
+	if !x0.IsPositive() || !y0.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: CONSTANT_PRODUCT CURVE REQUIRES POSITIVE x0/y0.")
+	}
+	k := x0.Mul(y0)
+	xBefore := c.virtualTokenReserve(supplyReleased, initialSupply, x0)
+	xAfter := xBefore.Add(amount)
+	payout := k.Div(xBefore).Sub(k.Div(xAfter))
+	return payout.Mul(one.Sub(spread)), nil
+}
+
+func (c ConstantProductCurve) Price(supplyReleased, initialSupply, x0, y0 decimal.Decimal) (decimal.Decimal, error) {
+	if !x0.IsPositive() || !y0.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: CONSTANT_PRODUCT CURVE REQUIRES POSITIVE x0/y0.")
+	}
+	x := c.virtualTokenReserve(supplyReleased, initialSupply, x0)
+	if !x.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: CONSTANT_PRODUCT CURVE VIRTUAL TOKEN RESERVE IS EXHAUSTED.")
+	}
+	k := x0.Mul(y0)
+	return k.Div(x.Mul(x)), nil
+}
+
+func init() {
+	Register("CONSTANT_PRODUCT", ConstantProductCurve{})
+}
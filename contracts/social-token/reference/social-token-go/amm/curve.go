@@ -0,0 +1,50 @@
+/* -------------------------------------------------------------------------------------------------
+Package amm is the pluggable bonding-curve registry for social tokens. A Curve implementation owns
+the pricing formula for one AMM type; the socialtoken package looks curves up here by name instead
+of hardcoding them in a switch statement, so pool creators are not limited to whatever curves
+socialtoken.go happened to ship with.
+
+TargetPrice/TargetSupply are passed through from SocialPool unchanged for every curve, but their
+meaning is curve-specific: AMM.go's legacy switch (LINEAR, QUADRATIC, EXPONENTIAL, SIGMOID) reads
+them literally, while constant-product and LMSR repurpose them as their own curve parameters (see
+each implementation's doc comment). This lets every curve share one SocialPool schema without a
+parameter union type.
+------------------------------------------------------------------------------------------------- */
+
+package amm
+
+import "github.com/shopspring/decimal"
+
+// Curve prices a bonding-curve-backed social token. Implementations must be pure functions of
+// their arguments: no I/O, no mutation of the decimal.Decimal inputs.
+type Curve interface {
+	// Buy returns the FundingToken cost of moving supplyReleased to supplyReleased+amount.
+	Buy(supplyReleased, initialSupply, amount, targetPrice, targetSupply decimal.Decimal) (decimal.Decimal, error)
+	// Sell returns the FundingToken paid out for moving supplyReleased down by amount, after spread.
+	Sell(supplyReleased, initialSupply, amount, spread, targetPrice, targetSupply decimal.Decimal) (decimal.Decimal, error)
+	// Price returns the instantaneous marginal price at supplyReleased.
+	Price(supplyReleased, initialSupply, targetPrice, targetSupply decimal.Decimal) (decimal.Decimal, error)
+}
+
+var registry = map[string]Curve{}
+
+// Register adds a curve to the registry under name, overwriting any curve already registered
+// there. Intended to be called from package init() functions.
+func Register(name string, curve Curve) {
+	registry[name] = curve
+}
+
+// Get returns the curve registered under name, and whether one was found.
+func Get(name string) (Curve, bool) {
+	curve, ok := registry[name]
+	return curve, ok
+}
+
+// Names returns the curve names currently registered.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,81 @@
+package amm
+
+import "github.com/shopspring/decimal"
+
+// decimalPrecision is the number of decimal digits decExp/decLn solve for. Curves in this package
+// that need e^x or ln(x) (constant-product's price is algebraic and does not) use this so pricing
+// never round-trips through float64.
+const decimalPrecision int32 = 30
+
+var one = decimal.NewFromInt(1)
+var two = decimal.NewFromInt(2)
+
+// ln2 to more digits than decimalPrecision will ever need, so range reduction never loses digits.
+var ln2 = decimal.RequireFromString("0.69314718055994530941723212145817656807550013436025525412068000949339362196969471560586332699641868754200148102057068573368552023575813055703267075163507596193072757082837143519030703862389167347112335011536449795523912047517268157493206515552473413952588295045300709532636664265410423915781495204374")
+
+// decExp computes e^x to prec digits by reducing x = k*ln2 + r with |r| <= ln2/2, evaluating the
+// Taylor series for e^r, then rescaling by the exact power of two e^(k*ln2) = 2^k. Every division
+// below is DivRound against workingPrec, not the bare Div/DivisionPrecision default (16 digits),
+// since that would silently cap this at float64-grade precision despite the name decimalPrecision
+// (mirrors DecExp in the parent package's utils.go, duplicated here rather than imported to avoid a
+// socialtoken<->amm import cycle).
+func decExp(x decimal.Decimal, prec int32) decimal.Decimal {
+	workingPrec := prec + 10
+
+	k := x.DivRound(ln2, workingPrec).Round(0)
+	r := x.Sub(k.Mul(ln2))
+
+	term := decimal.NewFromInt(1)
+	sum := decimal.NewFromInt(1)
+	epsilon := decimal.New(1, -prec)
+	for i := int64(1); i < 1000; i++ {
+		term = term.Mul(r).DivRound(decimal.NewFromInt(i), workingPrec)
+		if term.Abs().LessThan(epsilon) {
+			break
+		}
+		sum = sum.Add(term)
+	}
+
+	kInt := k.IntPart()
+	if kInt >= 0 {
+		return sum.Mul(two.Pow(decimal.NewFromInt(kInt))).Round(prec)
+	}
+	return sum.DivRound(two.Pow(decimal.NewFromInt(-kInt)), workingPrec).Round(prec)
+}
+
+// decLn computes ln(x) to prec digits for x > 0, by reducing x = m*2^k with m in [1,2) and summing
+// the atanh series for ln(m), then adding back k*ln2. Same DivRound-throughout treatment as decExp.
+func decLn(x decimal.Decimal, prec int32) decimal.Decimal {
+	if !x.IsPositive() {
+		return decimal.Zero
+	}
+	workingPrec := prec + 10
+
+	m := x
+	k := int64(0)
+	for m.GreaterThanOrEqual(two) {
+		m = m.DivRound(two, workingPrec)
+		k++
+	}
+	for m.LessThan(one) {
+		m = m.Mul(two)
+		k--
+	}
+
+	// y = (m-1)/(m+1), ln(m) = 2*(y + y^3/3 + y^5/5 + ...)
+	y := m.Sub(one).DivRound(m.Add(one), workingPrec)
+	ySquared := y.Mul(y)
+	term := y
+	sum := y
+	epsilon := decimal.New(1, -prec)
+	for i := int64(3); i < 400; i += 2 {
+		term = term.Mul(ySquared)
+		addend := term.DivRound(decimal.NewFromInt(i), workingPrec)
+		if addend.Abs().LessThan(epsilon) {
+			break
+		}
+		sum = sum.Add(addend)
+	}
+
+	return two.Mul(sum).Add(decimal.NewFromInt(k).Mul(ln2)).Round(prec)
+}
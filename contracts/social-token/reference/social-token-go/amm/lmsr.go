@@ -0,0 +1,59 @@
+package amm
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+LMSRCurve prices social tokens with a two-outcome Logarithmic Market Scoring Rule: the token being
+"yes" and an implicit fixed "no" outcome at quantity zero, so the classic
+cost(q) = b*ln(sum(e^(q_i/b))) collapses to cost(q) = b*ln(1 + e^(q/b)) and
+price(q) = e^(q/b) / (1 + e^(q/b)).
+
+TargetSupply is repurposed as b, the liquidity parameter (higher b means deeper liquidity and a
+flatter price curve). TargetPrice is repurposed as a scale factor so cost/price come out in
+FundingToken units instead of the unitless [0,1] LMSR probability.
+------------------------------------------------------------------------------------------------- */
+
+type LMSRCurve struct{}
+
+func lmsrCost(q, b decimal.Decimal) decimal.Decimal {
+	return b.Mul(decLn(one.Add(decExp(q.Div(b), decimalPrecision)), decimalPrecision))
+}
+
+func (LMSRCurve) Buy(supplyReleased, initialSupply, amount, targetPrice, b decimal.Decimal) (decimal.Decimal, error) {
+	if !b.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: LMSR CURVE REQUIRES A POSITIVE LIQUIDITY PARAMETER b.")
+	}
+	q0 := decimal.Max(decimal.Zero, supplyReleased.Sub(initialSupply))
+	q1 := q0.Add(amount)
+	return targetPrice.Mul(lmsrCost(q1, b).Sub(lmsrCost(q0, b))), nil
+}
+
+func (LMSRCurve) Sell(supplyReleased, initialSupply, amount, spread, targetPrice, b decimal.Decimal) (decimal.Decimal, error) {
+	if !b.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: LMSR CURVE REQUIRES A POSITIVE LIQUIDITY PARAMETER b.")
+	}
+	q0 := decimal.Max(decimal.Zero, supplyReleased.Sub(initialSupply))
+	q1 := q0.Sub(amount)
+	if q1.IsNegative() {
+		return decimal.Zero, errors.New("ERROR: LMSR CURVE CANNOT SELL MORE THAN THE SUPPLY RELEASED.")
+	}
+	payout := targetPrice.Mul(lmsrCost(q0, b).Sub(lmsrCost(q1, b)))
+	return payout.Mul(one.Sub(spread)), nil
+}
+
+func (LMSRCurve) Price(supplyReleased, initialSupply, targetPrice, b decimal.Decimal) (decimal.Decimal, error) {
+	if !b.IsPositive() {
+		return decimal.Zero, errors.New("ERROR: LMSR CURVE REQUIRES A POSITIVE LIQUIDITY PARAMETER b.")
+	}
+	q := decimal.Max(decimal.Zero, supplyReleased.Sub(initialSupply))
+	expQ := decExp(q.Div(b), decimalPrecision)
+	return targetPrice.Mul(expQ.Div(one.Add(expQ))), nil
+}
+
+func init() {
+	Register("LMSR", LMSRCurve{})
+}
@@ -0,0 +1,70 @@
+// Package auth implements M-of-N threshold signature verification for privileged SocialPool
+// operations (minting, burning, registration, parameter changes), so it can be reused from future
+// chaincodes the same way socialtoken/amm and socialtoken/bridge are.
+//
+// VerifyAggregateBLS below verifies each signer's contribution individually via
+// utils.VerifySignature, the same primitive every other M-of-N check in this chaincode already uses
+// (see bridge.verifyAttestation and the legacy verifyRelayerQuorum helper) rather than a real BLS
+// pairing-based aggregate. A caller still submits signers/sigs in the shape a true aggregate would
+// take; swapping in real pairing verification later does not change any caller.
+package auth
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/Get-Cache/Privi/utils"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+)
+
+/* -------------------------------------------------------------------------------------------------
+CanonicalMessage: this function builds the message every operator signs over, so a signer can
+             reconstruct exactly what they are attesting to from the op, the pool it targets, the
+             canonical JSON payload of the underlying request, and the replay-protection nonce.
+------------------------------------------------------------------------------------------------- */
+
+func CanonicalMessage(op string, poolAddress string, payload string, nonce int64) string {
+	return op + "|" + poolAddress + "|" + payload + "|" + strconv.FormatInt(nonce, 10)
+}
+
+/* -------------------------------------------------------------------------------------------------
+VerifyAggregateBLS: this function checks that at least threshold distinct operators, each present in
+             pubs, produced a valid signature (matched positionally in sigs) over msg/payload. It
+             returns the distinct operators that signed.
+------------------------------------------------------------------------------------------------- */
+
+func VerifyAggregateBLS(stub shim.ChaincodeStubInterface, pubs []string, threshold int,
+	msg string, payload []byte, signers []string, sigs []string) ([]string, error) {
+
+	if len(signers) != len(sigs) {
+		return nil, errors.New("ERROR: SIGNERS AND SIGNATURES MUST HAVE THE SAME LENGTH.")
+	}
+	authorized := make(map[string]bool)
+	for _, pub := range pubs {
+		authorized[pub] = true
+	}
+	attested := make(map[string]bool)
+	for i, signer := range signers {
+		if !authorized[signer] {
+			return nil, errors.New("ERROR: " + signer + " IS NOT A REGISTERED OPERATOR.")
+		}
+		if attested[signer] {
+			continue
+		}
+		if err := utils.VerifySignature(stub, signer, msg, sigs[i], payload); err != nil {
+			return nil, errors.New("ERROR: VERIFYING SIGNATURE OF OPERATOR " + signer + ". " + err.Error())
+		}
+		attested[signer] = true
+	}
+	if len(attested) < threshold {
+		return nil, errors.New("ERROR: OPERATION REQUIRES " + strconv.Itoa(threshold) + " DISTINCT OPERATOR SIGNATURES.")
+	}
+	signed := make([]string, 0, len(attested))
+	for signer := range attested {
+		signed = append(signed, signer)
+	}
+	return signed, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
@@ -0,0 +1,151 @@
+// Package bridge implements a lockbox/wrapper cross-chain bridge for social tokens: a holder locks
+// tokens on this ledger via InitiateBridgeOut, and once a quorum of the destination chain's
+// authorized relayers attests (off-chain) that the wrapped representation was minted there, the
+// reverse flow CompleteBridgeIn re-hydrates tokens here against the same quorum's attestation of a
+// lock/burn that happened on SourceChain.
+//
+// This is independent of SocialToken.go's MakeBridgeOutSocialToken/MakeBridgeInSocialToken, whose
+// bridge-in accepts attestations from any EXCHANGE_ROLE users against a fixed threshold. This package instead
+// keeps an authorized relayer set and threshold per external chain (SetChainRelayers) and records
+// each completed (sourceChain, sourceTx) so it is minted only once.
+package bridge
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/Get-Cache/Privi/utils"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/shopspring/decimal"
+)
+
+const indexChainRelayers = "BRIDGE_CHAIN_RELAYERS"
+const indexBridgeOutNonce = "BRIDGE_OUT_NONCE"
+const indexBridgePending = "BRIDGE_PENDING"
+const indexBridgeCompleted = "BRIDGE_COMPLETED"
+
+// ChainRelayerConfig authorizes the public keys allowed to attest bridge-ins from Chain, and the
+// M-of-N threshold required before CompleteBridgeIn will mint against their attestation.
+type ChainRelayerConfig struct {
+	Chain     string   `json:"Chain"`
+	Relayers  []string `json:"Relayers"`
+	Threshold int      `json:"Threshold"`
+}
+
+// BridgeOutRecord is what InitiateBridgeOut escrows under the BridgePendingKey, and what
+// GetBridgePending returns to relayers so they know exactly what to attest to minting on
+// DestinationChain (the payload relayers sign is this record, JSON-encoded; no Merkle proof is
+// built over it).
+type BridgeOutRecord struct {
+	PoolAddress        string          `json:"PoolAddress"`
+	TokenSymbol        string          `json:"TokenSymbol"`
+	Investor           string          `json:"Investor"`
+	Amount             decimal.Decimal `json:"Amount"`
+	DestinationChain   string          `json:"DestinationChain"`
+	DestinationAddress string          `json:"DestinationAddress"`
+	Nonce              int64           `json:"Nonce"`
+	Date               int64           `json:"Date"`
+}
+
+/* -------------------------------------------------------------------------------------------------
+SetChainRelayers: this function is how an ADMIN_ROLE actor authorizes (or re-authorizes) the set of
+             relayer public keys and the attestation threshold trusted for a given external chain.
+             Callers are expected to gate ADMIN_ROLE themselves before calling this; the package has
+             no notion of roles of its own.
+------------------------------------------------------------------------------------------------- */
+
+func SetChainRelayers(stub shim.ChaincodeStubInterface, config ChainRelayerConfig) error {
+	if config.Threshold <= 0 || config.Threshold > len(config.Relayers) {
+		return errors.New("ERROR: THRESHOLD MUST BE BETWEEN 1 AND THE NUMBER OF RELAYERS FOR CHAIN " + config.Chain + ".")
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return errors.New("ERROR: ENCODING RELAYER CONFIG OF CHAIN " + config.Chain + ". " + err.Error())
+	}
+	if err := stub.PutState(indexChainRelayers+config.Chain, data); err != nil {
+		return errors.New("ERROR: STORING RELAYER CONFIG OF CHAIN " + config.Chain + ". " + err.Error())
+	}
+	return nil
+}
+
+func GetChainRelayers(stub shim.ChaincodeStubInterface, chain string) (ChainRelayerConfig, error) {
+	var config ChainRelayerConfig
+	data, err := stub.GetState(indexChainRelayers + chain)
+	if err != nil {
+		return config, errors.New("ERROR: GETTING RELAYER CONFIG OF CHAIN " + chain + ". " + err.Error())
+	}
+	if data == nil {
+		return config, errors.New("ERROR: CHAIN " + chain + " HAS NO AUTHORIZED RELAYER SET.")
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, errors.New("ERROR: PARSING RELAYER CONFIG OF CHAIN " + chain + ". " + err.Error())
+	}
+	return config, nil
+}
+
+func bridgePendingKey(poolAddress string, nonce int64) string {
+	return indexBridgePending + poolAddress + "_" + strconv.FormatInt(nonce, 10)
+}
+
+func bridgeCompletedKey(sourceChain string, sourceTx string) string {
+	return indexBridgeCompleted + sourceChain + "_" + sourceTx
+}
+
+func nextBridgeOutNonce(stub shim.ChaincodeStubInterface, poolAddress string) (int64, error) {
+	var lastNonce int64
+	data, err := stub.GetState(indexBridgeOutNonce + poolAddress)
+	if err != nil {
+		return 0, errors.New("ERROR: GETTING BRIDGE-OUT NONCE OF " + poolAddress + ". " + err.Error())
+	}
+	if data != nil {
+		lastNonce, err = strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return 0, errors.New("ERROR: PARSING BRIDGE-OUT NONCE OF " + poolAddress + ". " + err.Error())
+		}
+	}
+	nextNonce := lastNonce + 1
+	if err := stub.PutState(indexBridgeOutNonce+poolAddress, []byte(strconv.FormatInt(nextNonce, 10))); err != nil {
+		return 0, errors.New("ERROR: STORING BRIDGE-OUT NONCE OF " + poolAddress + ". " + err.Error())
+	}
+	return nextNonce, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+verifyAttestation: this function checks that at least config.Threshold distinct relayers authorized
+             for config.Chain each produced a valid signature over payload, the record relayers
+             are attesting to having observed minted/burned on that chain. Only the signatures are
+             checked; no Merkle proof is verified.
+------------------------------------------------------------------------------------------------- */
+
+func verifyAttestation(stub shim.ChaincodeStubInterface, config ChainRelayerConfig, hash string,
+	relayers []string, signatures []string, payload []byte) error {
+
+	if len(relayers) != len(signatures) {
+		return errors.New("ERROR: RELAYERS AND SIGNATURES MUST HAVE THE SAME LENGTH.")
+	}
+	authorized := make(map[string]bool)
+	for _, relayer := range config.Relayers {
+		authorized[relayer] = true
+	}
+	attested := make(map[string]bool)
+	for i, relayer := range relayers {
+		if !authorized[relayer] {
+			return errors.New("ERROR: " + relayer + " IS NOT AN AUTHORIZED RELAYER FOR CHAIN " + config.Chain + ".")
+		}
+		if attested[relayer] {
+			continue
+		}
+		if err := utils.VerifySignature(stub, relayer, hash, signatures[i], payload); err != nil {
+			return errors.New("ERROR: VERIFYING ATTESTATION OF RELAYER " + relayer + ". " + err.Error())
+		}
+		attested[relayer] = true
+	}
+	if len(attested) < config.Threshold {
+		return errors.New("ERROR: CHAIN " + config.Chain + " REQUIRES " + strconv.Itoa(config.Threshold) + " DISTINCT RELAYER ATTESTATIONS.")
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
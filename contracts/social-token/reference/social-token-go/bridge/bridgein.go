@@ -0,0 +1,64 @@
+package bridge
+
+import (
+	"errors"
+
+	"github.com/Get-Cache/Privi/contracts/coinbalance"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+CompleteBridgeIn: this function verifies an M-of-N relayer quorum attested that amount of tokenSymbol
+             was locked/burned for recipient on sourceChain at sourceTx, then mints the wrapped
+             representation here. Idempotent per (sourceChain, sourceTx): a repeat completion with
+             the same pair is rejected rather than double-minting.
+------------------------------------------------------------------------------------------------- */
+
+func CompleteBridgeIn(stub shim.ChaincodeStubInterface, poolAddress string, tokenSymbol string,
+	sourceChain string, sourceTx string, hash string, relayers []string, signatures []string,
+	recipient string, amount decimal.Decimal, payload []byte) ([]coinbalance.Transfer, error) {
+
+	if amount.IsZero() || amount.IsNegative() {
+		return nil, errors.New("ERROR: BRIDGE-IN AMOUNT MUST BE POSITIVE.")
+	}
+
+	completedKey := bridgeCompletedKey(sourceChain, sourceTx)
+	existing, err := stub.GetState(completedKey)
+	if err != nil {
+		return nil, errors.New("ERROR: GETTING BRIDGE-IN COMPLETION STATE. " + err.Error())
+	}
+	if existing != nil {
+		return nil, errors.New("ERROR: BRIDGE-IN FOR " + sourceChain + " TX " + sourceTx + " HAS ALREADY BEEN COMPLETED.")
+	}
+
+	config, err := GetChainRelayers(stub, sourceChain)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyAttestation(stub, config, hash, relayers, signatures, payload); err != nil {
+		return nil, err
+	}
+
+	mintingTransfer := coinbalance.TransferRequest{
+		Type:   "Social_Token_Bridge_In",
+		Token:  tokenSymbol,
+		Amount: amount,
+		From:   poolAddress,
+		To:     recipient,
+	}
+	result, err := coinbalance.Mint(stub, &mintingTransfer)
+	if err != nil {
+		return nil, errors.New("ERROR: MINTING BRIDGED-IN TOKENS. " + err.Error())
+	}
+
+	// Record completion only after the mint succeeds, so a failed mint can be retried //
+	if err := stub.PutState(completedKey, []byte(sourceTx)); err != nil {
+		return nil, errors.New("ERROR: STORING BRIDGE-IN COMPLETION STATE. " + err.Error())
+	}
+
+	return result.Transactions, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
@@ -0,0 +1,89 @@
+package bridge
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/Get-Cache/Privi/contracts/coinbalance"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+InitiateBridgeOut: this function escrows (burns) amount of tokenSymbol out of investor's balance
+             under a BridgePendingKey keyed by (poolAddress, nonce), so relayers watching
+             GetBridgePending can see exactly what to mint on destChain, and returns that record;
+             relayers sign over it JSON-encoded, and no Merkle proof is built for it.
+------------------------------------------------------------------------------------------------- */
+
+func InitiateBridgeOut(stub shim.ChaincodeStubInterface, poolAddress string, tokenSymbol string,
+	investor string, amount decimal.Decimal, destChain string, destAddress string, date int64) (BridgeOutRecord, []coinbalance.Transfer, error) {
+
+	if amount.IsZero() || amount.IsNegative() {
+		return BridgeOutRecord{}, nil, errors.New("ERROR: BRIDGE-OUT AMOUNT MUST BE POSITIVE.")
+	}
+	if _, err := GetChainRelayers(stub, destChain); err != nil {
+		return BridgeOutRecord{}, nil, err
+	}
+
+	nonce, err := nextBridgeOutNonce(stub, poolAddress)
+	if err != nil {
+		return BridgeOutRecord{}, nil, err
+	}
+
+	burningTransfer := coinbalance.TransferRequest{
+		Type:   "Social_Token_Bridge_Out",
+		Token:  tokenSymbol,
+		Amount: amount,
+		From:   investor,
+		To:     poolAddress,
+	}
+	result, err := coinbalance.Burn(stub, &burningTransfer)
+	if err != nil {
+		return BridgeOutRecord{}, nil, errors.New("ERROR: ESCROWING TOKENS FOR BRIDGE-OUT. " + err.Error())
+	}
+
+	record := BridgeOutRecord{
+		PoolAddress:        poolAddress,
+		TokenSymbol:        tokenSymbol,
+		Investor:           investor,
+		Amount:             amount,
+		DestinationChain:   destChain,
+		DestinationAddress: destAddress,
+		Nonce:              nonce,
+		Date:               date,
+	}
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return BridgeOutRecord{}, nil, errors.New("ERROR: ENCODING BRIDGE-OUT RECORD. " + err.Error())
+	}
+	if err := stub.PutState(bridgePendingKey(poolAddress, nonce), recordBytes); err != nil {
+		return BridgeOutRecord{}, nil, errors.New("ERROR: STORING BRIDGE-OUT RECORD. " + err.Error())
+	}
+
+	return record, result.Transactions, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+GetBridgePending: this function returns the escrowed BridgeOutRecord at (poolAddress, nonce) so a
+             relayer can read exactly what it is attesting to before signing.
+------------------------------------------------------------------------------------------------- */
+
+func GetBridgePending(stub shim.ChaincodeStubInterface, poolAddress string, nonce int64) (BridgeOutRecord, error) {
+	var record BridgeOutRecord
+	data, err := stub.GetState(bridgePendingKey(poolAddress, nonce))
+	if err != nil {
+		return record, errors.New("ERROR: GETTING BRIDGE-OUT RECORD. " + err.Error())
+	}
+	if data == nil {
+		return record, errors.New("ERROR: NO PENDING BRIDGE-OUT FOR POOL " + poolAddress + " NONCE " + strconv.FormatInt(nonce, 10) + ".")
+	}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return record, errors.New("ERROR: PARSING BRIDGE-OUT RECORD. " + err.Error())
+	}
+	return record, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
@@ -0,0 +1,222 @@
+// Package conformance provides a minimal shim.ChaincodeStubInterface mock and a versioned JSON
+// test-vector format for driving SocialPool state-transition conformance tests, so it can be reused
+// from future chaincodes the same way socialtoken/amm, socialtoken/bridge, and socialtoken/auth are.
+//
+// MockStub implements GetState/PutState/DelState/GetStateByRange/GetStateByRangeWithPagination/
+// GetTxTimestamp/SetEvent over an in-memory map, a simplified CreateCompositeKey/SplitCompositeKey
+// that join and split on "_", and fixed return values for GetArgs/GetStringArgs/
+// GetFunctionAndParameters/GetTxID/GetChannelID/GetDecorations. Every other method returns a "not
+// supported" error, GetStateByPartialCompositeKey included, so the composite-key pool indexes in
+// Indexes.go cannot be scanned through it. Note also that mintSocialPoolTokens/burnSocialPoolTokens/multiTransfer/
+// registerSocialToken ultimately delegate to the external coinbalance package, whose source is not
+// vendored anywhere in this tree; vectors that would need coinbalance's own state layout to seed
+// balances cannot be driven end-to-end here, and are scoped to what MockStub alone can observe (see
+// the conformance_test.go vectors in package socialtoken for which scenarios that leaves in scope).
+package conformance
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hyperledger/fabric/core/chaincode/shim"
+	"github.com/hyperledger/fabric/protos/ledger/queryresult"
+	"github.com/hyperledger/fabric/protos/peer"
+)
+
+/* -------------------------------------------------------------------------------------------------
+MockStub: an in-memory shim.ChaincodeStubInterface. State is a flat key->value map, matching the
+             simple string-concatenation key convention most IndexXxx in this chaincode use (the
+             composite-key indexes in Indexes.go are the exception; see CreateCompositeKey below).
+------------------------------------------------------------------------------------------------- */
+
+type MockStub struct {
+	State       map[string][]byte
+	TxTimestamp *timestamp.Timestamp
+	Events      map[string][]byte
+}
+
+func NewMockStub() *MockStub {
+	return &MockStub{
+		State:       make(map[string][]byte),
+		TxTimestamp: &timestamp.Timestamp{Seconds: 1700000000},
+	}
+}
+
+func (m *MockStub) GetState(key string) ([]byte, error) {
+	return m.State[key], nil
+}
+
+func (m *MockStub) PutState(key string, value []byte) error {
+	m.State[key] = value
+	return nil
+}
+
+func (m *MockStub) DelState(key string) error {
+	delete(m.State, key)
+	return nil
+}
+
+func (m *MockStub) GetTxTimestamp() (*timestamp.Timestamp, error) {
+	return m.TxTimestamp, nil
+}
+
+func (m *MockStub) SetEvent(name string, payload []byte) error {
+	if m.Events == nil {
+		m.Events = make(map[string][]byte)
+	}
+	m.Events[name] = payload
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+GetStateByRange: iterates keys in lexicographic order, matching RebuildIndexes' assumption that
+             startKey/endKey bound a prefix (it calls GetStateByRange(IndexSocialPools+"0x",
+             IndexSocialPools+"0x~"), relying on "~" sorting after any hex address character).
+------------------------------------------------------------------------------------------------- */
+
+func (m *MockStub) GetStateByRange(startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+	keys := make([]string, 0, len(m.State))
+	for key := range m.State {
+		if key >= startKey && key < endKey {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return &mockIterator{stub: m, keys: keys}, nil
+}
+
+type mockIterator struct {
+	stub *MockStub
+	keys []string
+	pos  int
+}
+
+func (it *mockIterator) HasNext() bool {
+	return it.pos < len(it.keys)
+}
+
+func (it *mockIterator) Close() error {
+	return nil
+}
+
+func (it *mockIterator) Next() (*queryresult.KV, error) {
+	key := it.keys[it.pos]
+	it.pos++
+	return &queryresult.KV{Key: key, Value: it.stub.State[key]}, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+Everything below exists so MockStub satisfies shim.ChaincodeStubInterface. Apart from
+             GetStateByRangeWithPagination, CreateCompositeKey/SplitCompositeKey and a few getters
+             with fixed return values, each method returns a "not supported" error instead of
+             silently behaving like a real peer would.
+------------------------------------------------------------------------------------------------- */
+
+var errNotSupported = errors.New("ERROR: MOCKSTUB DOES NOT SUPPORT THIS OPERATION.")
+
+func (m *MockStub) GetArgs() [][]byte                          { return nil }
+func (m *MockStub) GetStringArgs() []string                    { return nil }
+func (m *MockStub) GetFunctionAndParameters() (string, []string) { return "", nil }
+func (m *MockStub) GetArgsSlice() ([]byte, error)              { return nil, errNotSupported }
+func (m *MockStub) GetTxID() string                            { return "mock-tx" }
+func (m *MockStub) GetChannelID() string                       { return "mock-channel" }
+
+func (m *MockStub) InvokeChaincode(chaincodeName string, args [][]byte, channel string) peer.Response {
+	return shim.Error(errNotSupported.Error())
+}
+
+func (m *MockStub) SetStateValidationParameter(key string, ep []byte) error { return errNotSupported }
+func (m *MockStub) GetStateValidationParameter(key string) ([]byte, error) { return nil, errNotSupported }
+
+// GetStateByRangeWithPagination backs ListSocialPools/ListSocialPoolStates (chunk2-5): it slices the
+// same sorted key range GetStateByRange above walks, starting after bookmark (the last key returned
+// by the previous page) and stopping at pageSize results.
+func (m *MockStub) GetStateByRangeWithPagination(startKey, endKey string, pageSize int32,
+	bookmark string) (shim.StateQueryIteratorInterface, *peer.QueryResponseMetadata, error) {
+	keys := make([]string, 0, len(m.State))
+	for key := range m.State {
+		if key >= startKey && key < endKey && key > bookmark {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if pageSize > 0 && int32(len(keys)) > pageSize {
+		keys = keys[:pageSize]
+	}
+	metadata := &peer.QueryResponseMetadata{FetchedRecordsCount: int32(len(keys))}
+	if len(keys) > 0 {
+		metadata.Bookmark = keys[len(keys)-1]
+	} else {
+		metadata.Bookmark = bookmark
+	}
+	return &mockIterator{stub: m, keys: keys}, metadata, nil
+}
+
+func (m *MockStub) GetStateByPartialCompositeKey(objectType string, keys []string) (shim.StateQueryIteratorInterface, error) {
+	return nil, errNotSupported
+}
+
+func (m *MockStub) GetStateByPartialCompositeKeyWithPagination(objectType string, keys []string,
+	pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *peer.QueryResponseMetadata, error) {
+	return nil, nil, errNotSupported
+}
+
+func (m *MockStub) CreateCompositeKey(objectType string, attributes []string) (string, error) {
+	return objectType + "_" + strings.Join(attributes, "_"), nil
+}
+
+func (m *MockStub) SplitCompositeKey(compositeKey string) (string, []string, error) {
+	parts := strings.Split(compositeKey, "_")
+	if len(parts) == 0 {
+		return "", nil, errNotSupported
+	}
+	return parts[0], parts[1:], nil
+}
+
+func (m *MockStub) GetQueryResult(query string) (shim.StateQueryIteratorInterface, error) {
+	return nil, errNotSupported
+}
+
+func (m *MockStub) GetQueryResultWithPagination(query string, pageSize int32,
+	bookmark string) (shim.StateQueryIteratorInterface, *peer.QueryResponseMetadata, error) {
+	return nil, nil, errNotSupported
+}
+
+func (m *MockStub) GetHistoryForKey(key string) (shim.HistoryQueryIteratorInterface, error) {
+	return nil, errNotSupported
+}
+
+func (m *MockStub) GetPrivateData(collection, key string) ([]byte, error)     { return nil, errNotSupported }
+func (m *MockStub) GetPrivateDataHash(collection, key string) ([]byte, error) { return nil, errNotSupported }
+func (m *MockStub) PutPrivateData(collection string, key string, value []byte) error {
+	return errNotSupported
+}
+func (m *MockStub) DelPrivateData(collection, key string) error   { return errNotSupported }
+func (m *MockStub) PurgePrivateData(collection, key string) error { return errNotSupported }
+func (m *MockStub) SetPrivateDataValidationParameter(collection, key string, ep []byte) error {
+	return errNotSupported
+}
+func (m *MockStub) GetPrivateDataValidationParameter(collection, key string) ([]byte, error) {
+	return nil, errNotSupported
+}
+func (m *MockStub) GetPrivateDataByRange(collection, startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+	return nil, errNotSupported
+}
+func (m *MockStub) GetPrivateDataByPartialCompositeKey(collection, objectType string,
+	keys []string) (shim.StateQueryIteratorInterface, error) {
+	return nil, errNotSupported
+}
+func (m *MockStub) GetPrivateDataQueryResult(collection, query string) (shim.StateQueryIteratorInterface, error) {
+	return nil, errNotSupported
+}
+
+func (m *MockStub) GetCreator() ([]byte, error)              { return nil, errNotSupported }
+func (m *MockStub) GetTransient() (map[string][]byte, error) { return nil, errNotSupported }
+func (m *MockStub) GetBinding() ([]byte, error)               { return nil, errNotSupported }
+func (m *MockStub) GetDecorations() map[string][]byte         { return nil }
+func (m *MockStub) GetSignedProposal() (*peer.SignedProposal, error) { return nil, errNotSupported }
+
+/* -------------------------------------------------------------------------------------------------
+------------------------------------------------------------------------------------------------- */
@@ -0,0 +1,246 @@
+//go:build conformance
+// +build conformance
+
+package socialtoken
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/Get-Cache/Privi/contracts/socialtoken/conformance"
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+TestSocialPoolConformanceVectors drives the versioned corpus under testdata/conformance/*.json through
+             a conformance.MockStub so a refactor of pool-state-transition gating regresses here
+             first, not in production. Vectors are grouped by Vector.Fn; each group's preState/action/
+             expected JSON is scenario-specific and decoded independently below.
+
+Every one of the original request's named targets (registerSocialToken, multiTransfer,
+             mintSocialPoolTokens, burnSocialPoolTokens) delegates its actual effect to the external
+             coinbalance package the moment it clears this chaincode's own gating, and coinbalance's
+             source is not vendored anywhere in this tree, so none of them can be driven end-to-end
+             here. Where a named scenario is instead exercised through the one guard this chaincode
+             does own, vector.Represents records that substitution explicitly (surfaced in the subtest
+             name below) instead of leaving it to a single blanket disclaimer; where no substitution is
+             possible at all, the vector says so and skips rather than silently omitting coverage.
+------------------------------------------------------------------------------------------------- */
+
+func TestSocialPoolConformanceVectors(t *testing.T) {
+	vectors, err := conformance.LoadVectors("testdata/conformance")
+	if err != nil {
+		t.Fatalf("loading conformance vectors: %s", err.Error())
+	}
+
+	for _, vector := range vectors {
+		vector := vector
+		name := fmt.Sprintf("%s/%s", vector.Fn, vector.Name)
+		if vector.Represents != "" {
+			name = fmt.Sprintf("%s/represents=%s", name, vector.Represents)
+		}
+		t.Run(name, func(t *testing.T) {
+			switch vector.Fn {
+			case "effectiveSupplyReleased":
+				runEffectiveSupplyReleasedVector(t, vector)
+			case "assertPoolActive":
+				runAssertPoolActiveVector(t, vector)
+			case "assertPoolUnlocked":
+				runAssertPoolUnlockedVector(t, vector)
+			case "resolveTokenIDs":
+				runResolveTokenIDsVector(t, vector)
+			case "multiTransfer":
+				runMultiTransferVector(t, vector)
+			default:
+				t.Fatalf("unknown conformance vector fn %q", vector.Fn)
+			}
+		})
+	}
+}
+
+/* -------------------------------------------------------------------------------------------------
+effectiveSupplyReleased vectors: initial-supply rounding across a SupplyReleased/BridgedSupply split.
+------------------------------------------------------------------------------------------------- */
+
+func runEffectiveSupplyReleasedVector(t *testing.T, vector conformance.Vector) {
+	var pre struct {
+		SupplyReleased string `json:"supplyReleased"`
+		BridgedSupply  string `json:"bridgedSupply"`
+	}
+	if err := json.Unmarshal(vector.PreState, &pre); err != nil {
+		t.Fatalf("parsing preState: %s", err.Error())
+	}
+	var expected struct {
+		EffectiveSupplyReleased string `json:"effectiveSupplyReleased"`
+	}
+	if err := json.Unmarshal(vector.Expected, &expected); err != nil {
+		t.Fatalf("parsing expected: %s", err.Error())
+	}
+
+	poolState := SocialPoolState{
+		SupplyReleased: mustDecimal(t, pre.SupplyReleased),
+		BridgedSupply:  mustDecimal(t, pre.BridgedSupply),
+	}
+	got := effectiveSupplyReleased(poolState)
+	want := mustDecimal(t, expected.EffectiveSupplyReleased)
+	if !got.Equal(want) {
+		t.Errorf("got %s, expected %s", got.String(), want.String())
+	}
+}
+
+/* -------------------------------------------------------------------------------------------------
+assertPoolActive vectors: a halt with HaltUntil in the past auto-lifts; one with HaltUntil == 0 or in
+             the future does not. Not one of the original request's named scenarios; added as coverage
+             for the halt/resume gate (chunk0-3) once it existed.
+------------------------------------------------------------------------------------------------- */
+
+func runAssertPoolActiveVector(t *testing.T, vector conformance.Vector) {
+	var pre struct {
+		Halted      bool  `json:"halted"`
+		HaltUntil   int64 `json:"haltUntil"`
+		TxTimestamp int64 `json:"txTimestamp"`
+	}
+	if err := json.Unmarshal(vector.PreState, &pre); err != nil {
+		t.Fatalf("parsing preState: %s", err.Error())
+	}
+	var expected struct {
+		Err bool `json:"err"`
+	}
+	if err := json.Unmarshal(vector.Expected, &expected); err != nil {
+		t.Fatalf("parsing expected: %s", err.Error())
+	}
+
+	stub := conformance.NewMockStub()
+	stub.TxTimestamp.Seconds = pre.TxTimestamp
+	poolState := SocialPoolState{Halted: pre.Halted, HaltUntil: pre.HaltUntil}
+
+	err := assertPoolActive(stub, poolState)
+	if expected.Err && err == nil {
+		t.Errorf("expected an error, got none")
+	}
+	if !expected.Err && err != nil {
+		t.Errorf("expected no error, got %s", err.Error())
+	}
+}
+
+/* -------------------------------------------------------------------------------------------------
+assertPoolUnlocked vectors: a global lock blocks every function regardless of AllowedWhileLocked; a
+             pool lock blocks everything except the function names it allowlists. This is the one
+             guard mintSocialPoolTokens/burnSocialPoolTokens enforce themselves before handing off to
+             coinbalance, so the "mint above/below bonding-curve caps" and "burn-with-lockup-not-
+             expired" vectors below stand in here (see each vector's Represents) rather than at the
+             actual mint/burn call, which this tree cannot drive without coinbalance's source.
+------------------------------------------------------------------------------------------------- */
+
+func runAssertPoolUnlockedVector(t *testing.T, vector conformance.Vector) {
+	var pre struct {
+		GlobalLocked       bool     `json:"globalLocked"`
+		Locked             bool     `json:"locked"`
+		AllowedWhileLocked []string `json:"allowedWhileLocked"`
+	}
+	if err := json.Unmarshal(vector.PreState, &pre); err != nil {
+		t.Fatalf("parsing preState: %s", err.Error())
+	}
+	var action struct {
+		FunctionName string `json:"functionName"`
+	}
+	if err := json.Unmarshal(vector.Action, &action); err != nil {
+		t.Fatalf("parsing action: %s", err.Error())
+	}
+	var expected struct {
+		Err bool `json:"err"`
+	}
+	if err := json.Unmarshal(vector.Expected, &expected); err != nil {
+		t.Fatalf("parsing expected: %s", err.Error())
+	}
+
+	stub := conformance.NewMockStub()
+	if pre.GlobalLocked {
+		if err := setGlobalLocked(stub, true); err != nil {
+			t.Fatalf("seeding global lock: %s", err.Error())
+		}
+	}
+	poolState := SocialPoolState{Locked: pre.Locked, AllowedWhileLocked: pre.AllowedWhileLocked}
+
+	err := assertPoolUnlocked(stub, poolState, "mock-pool", action.FunctionName)
+	if expected.Err && err == nil {
+		t.Errorf("expected an error, got none")
+	}
+	if !expected.Err && err != nil {
+		t.Errorf("expected no error, got %s", err.Error())
+	}
+}
+
+/* -------------------------------------------------------------------------------------------------
+resolveTokenIDs vectors: two pools sharing one TokenSymbol resolve as ambiguous rather than one
+             silently shadowing the other, since collisions are accepted by design (chunk1-5). Stands
+             in for the "symbol-collision on registerSocialToken" scenario (see Represents): the
+             collision itself is only observable on lookup, since registerSocialToken's own write path
+             delegates straight to coinbalance.RegisterToken with no collision check of its own.
+------------------------------------------------------------------------------------------------- */
+
+func runResolveTokenIDsVector(t *testing.T, vector conformance.Vector) {
+	var pre struct {
+		Symbol   string   `json:"symbol"`
+		TokenIDs []uint64 `json:"tokenIDs"`
+	}
+	if err := json.Unmarshal(vector.PreState, &pre); err != nil {
+		t.Fatalf("parsing preState: %s", err.Error())
+	}
+	var expected struct {
+		Ambiguous bool `json:"ambiguous"`
+		Count     int  `json:"count"`
+	}
+	if err := json.Unmarshal(vector.Expected, &expected); err != nil {
+		t.Fatalf("parsing expected: %s", err.Error())
+	}
+
+	stub := conformance.NewMockStub()
+	for _, tokenID := range pre.TokenIDs {
+		if err := addTokenIDToSymbolIndex(stub, pre.Symbol, tokenID); err != nil {
+			t.Fatalf("seeding symbol index: %s", err.Error())
+		}
+	}
+
+	tokenIDs, err := resolveTokenIDs(stub, pre.Symbol)
+	if err != nil {
+		t.Fatalf("resolveTokenIDs: %s", err.Error())
+	}
+	if len(tokenIDs) != expected.Count {
+		t.Errorf("got %d matching token ids, expected %d", len(tokenIDs), expected.Count)
+	}
+	if expected.Ambiguous != (len(tokenIDs) > 1) {
+		t.Errorf("got ambiguous=%v, expected %v", len(tokenIDs) > 1, expected.Ambiguous)
+	}
+}
+
+/* -------------------------------------------------------------------------------------------------
+multiTransfer vectors: recorded rather than run. multiTransfer's own gating (assertPoolUnlocked) is
+             already exercised above; everything the "multi-transfer atomicity (all-or-nothing on one
+             failing leg)" scenario actually asks about happens inside coinbalance.Multitransfer once
+             multiTransfer hands off to it, and coinbalance's source is not vendored anywhere in this
+             tree. Rather than drop the scenario silently, its vector records the gap and skips instead
+             of asserting something this package cannot observe.
+------------------------------------------------------------------------------------------------- */
+
+func runMultiTransferVector(t *testing.T, vector conformance.Vector) {
+	var expected struct {
+		Skip string `json:"skip"`
+	}
+	if err := json.Unmarshal(vector.Expected, &expected); err != nil {
+		t.Fatalf("parsing expected: %s", err.Error())
+	}
+	if expected.Skip == "" {
+		t.Fatalf("multiTransfer vector %q has no skip reason and no other coverage is implemented", vector.Name)
+	}
+	t.Skip(expected.Skip)
+}
+
+func mustDecimal(t *testing.T, value string) decimal.Decimal {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		t.Fatalf("parsing decimal %q: %s", value, err.Error())
+	}
+	return d
+}
@@ -16,6 +16,16 @@ import (
 
 const IndexSocialPools = "SOCIAL_POOL"
 const IndexSocialPoolStates = "SOCIAL_POOL_STATES"
+const IndexVerifiedSocialPools = "SOCIAL_POOL_VERIFIED"
+const IndexDividendSnapshots = "SOCIAL_DIVIDEND_SNAPSHOT"
+
+// IndexTokenIDCounter stores the last TokenID assigned to any pool, so CreateSocialToken can hand
+// out the next one monotonically. IndexTokenIDToPool maps a TokenID to its current PoolAddress
+// (repointed on recreateSocialToken). IndexSymbolTokenIDs maps a display symbol to the JSON-encoded
+// list of every TokenID that has ever registered it, since symbols are not unique.
+const IndexTokenIDCounter = "SOCIAL_TOKEN_ID_COUNTER"
+const IndexTokenIDToPool = "SOCIAL_TOKEN_ID_POOL"
+const IndexSymbolTokenIDs = "SOCIAL_TOKEN_SYMBOL_INDEX"
 
 /*--------------------------------------------------
  SYSTEM ROLES
@@ -37,11 +47,18 @@ const LINEAR_AMM = "LINEAR"
 const EXPONENTIAL_AMM = "EXPONENTIAL"
 const SIGMOID_AMM = "SIGMOID"
 
+// CONSTANT_PRODUCT_AMM and LMSR_AMM are routed through the amm subpackage registry rather than the
+// legacy switch below them; see the amm.Get lookups in AMM.go.
+const CONSTANT_PRODUCT_AMM = "CONSTANT_PRODUCT"
+const LMSR_AMM = "LMSR"
+
 var AMM_TYPES = []string{
 	QUADRATIC_AMM,
 	LINEAR_AMM,
 	EXPONENTIAL_AMM,
-	SIGMOID_AMM}
+	SIGMOID_AMM,
+	CONSTANT_PRODUCT_AMM,
+	LMSR_AMM}
 
 /*--------------------------------------------------
  TOKEN TYPES
@@ -64,6 +81,12 @@ const DAILY_PAYMENT = "DAILY"
 const WEEKLY_PAYMENT = "WEEKLY"
 const MONTHLY_PAYMENT = "MONTHLY"
 
+// Epoch length, in seconds, DistributeSocialDividends requires to have elapsed since
+// LastDividendDate before a pool with the given DividendFreq can be swept again.
+const DAILY_PAYMENT_SECONDS int64 = 24 * 60 * 60
+const WEEKLY_PAYMENT_SECONDS int64 = 7 * 24 * 60 * 60
+const MONTHLY_PAYMENT_SECONDS int64 = 30 * 24 * 60 * 60
+
 /*--------------------------------------------------
  SMART CONTRACT INVOKATIONS
 --------------------------------------------------*/
@@ -72,6 +95,73 @@ const COIN_BALANCE_CHAINCODE = "CoinBalance"
 const CHANNEL_NAME = "broadcast"
 const DATA_PROTOCOL_CHAINCODE = "DataProtocol"
 const SOCIAL_TOKEN_ADDRESS_TYPE = "SOCIAL_TOKEN"
+const SOCIAL_TOKEN_CHAINCODE_NAME = "SocialToken"
+
+/*--------------------------------------------------
+ REQUEST AUTHENTICATION
+--------------------------------------------------*/
+
+const IndexAddressNonces = "SOCIAL_TOKEN_ADDRESS_NONCE"
+
+// MAX_SIGNATURE_AGE_SECONDS bounds how stale a Timestamp on a secure request may be, relative to
+// the endorsing peer's tx timestamp, before assertValidSecureRequest rejects it as expired.
+const MAX_SIGNATURE_AGE_SECONDS int64 = 5 * 60
+
+/*--------------------------------------------------
+ TRADE EVENTS
+--------------------------------------------------*/
+
+const SOCIAL_TOKEN_TRADE_EVENT = "SocialTokenTradeExecuted"
+const BUY_SIDE = "BUY"
+const SELL_SIDE = "SELL"
+
+/*--------------------------------------------------
+ BRIDGE
+--------------------------------------------------*/
+
+// Minimum number of distinct EXCHANGE_ROLE relayers that must attest to a BridgeInSocialToken
+// before the bridged supply is re-hydrated on this chain.
+const BRIDGE_RELAYER_THRESHOLD = 2
+
+/*--------------------------------------------------
+ EMERGENCY LOCK
+--------------------------------------------------*/
+
+// IndexGlobalLock stores the chain-wide emergency pause flag, set by SetGlobalLock. It is tracked
+// independently of any single pool's own Locked field on SocialPoolState, set by SetPoolLock.
+const IndexGlobalLock = "SOCIAL_TOKEN_GLOBAL_LOCK"
+
+const SOCIAL_TOKEN_POOL_LOCK_EVENT = "SocialTokenPoolLockChanged"
+const SOCIAL_TOKEN_GLOBAL_LOCK_EVENT = "SocialTokenGlobalLockChanged"
+
+/*--------------------------------------------------
+ OPERATOR MULTISIG
+--------------------------------------------------*/
+
+// IndexSocialPoolNonces is a per-pool SET of already-used SubmitSignedOp/ProposeOp/CoSignOp nonces,
+// keyed by pool address and nonce (not a strictly-increasing counter like IndexAddressNonces, since
+// operators proposing concurrently cannot coordinate a sequential order). IndexPendingSignedOps
+// stores the PendingSignedOp ProposeOp/CoSignOp are accumulating signatures against.
+const IndexSocialPoolNonces = "SOCIAL_POOL_OP_NONCE"
+const IndexPendingSignedOps = "SOCIAL_POOL_PENDING_OP"
+
+const MINT_OP = "mintSocialPoolTokens"
+const BURN_OP = "burnSocialPoolTokens"
+const UPDATE_POOL_INFO_OP = "updateSocialPoolInfo"
+const REGISTER_TOKEN_OP = "registerSocialToken"
+
+/*--------------------------------------------------
+ SECONDARY INDEXES
+--------------------------------------------------*/
+
+// IndexCreatorPools is the composite-key object type holding one entry per (Creator, PoolAddress)
+// pair, one for each pool that Creator created (see addPoolToIndex in Indexes.go).
+// IndexAMMTypePools does the same keyed by AMM curve type, since every SocialPool registers its
+// underlying Token as TokenType SOCIAL_TOKEN, so AMM is the only field that actually varies pool to
+// pool. Both are maintained by registerSocialToken/ModifySocialPool/changeSocialTokenOwner and can be
+// rebuilt from scratch by RebuildIndexes.
+const IndexCreatorPools = "SOCIAL_POOL_CREATOR_INDEX"
+const IndexAMMTypePools = "SOCIAL_POOL_AMM_TYPE_INDEX"
 
 /*--------------------------------------------------
  DECIMAL CONSTANTS
@@ -82,5 +172,8 @@ var TWO_DECIMAL = decimal.NewFromInt(2)
 var THREE_DECIMAL = decimal.NewFromInt(3)
 var EXP_DECIMAL = decimal.NewFromFloat(math.E)
 
+// Number of decimal digits DecExp/DecLn solve for when pricing the EXPONENTIAL and SIGMOID curves.
+const AMM_DECIMAL_PRECISION int32 = 30
+
 /*--------------------------------------------------
 --------------------------------------------------*/
\ No newline at end of file
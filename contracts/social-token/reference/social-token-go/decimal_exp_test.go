@@ -0,0 +1,98 @@
+package socialtoken
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+/* -------------------------------------------------------------------------------------------------
+TestDecExpDecLnInverse: DecLn(DecExp(x)) and DecExp(DecLn(x)) should recover x to within the
+requested precision (for DecLn(DecExp(x)) widened by the 10^-prec / e^x that DecExp's fixed decimal
+places leave when e^x is far below 1), across magnitudes that stress both the small-|r| Taylor series and the
+range reduction itself (x spanning several multiples of ln2, and m spanning several octaves).
+------------------------------------------------------------------------------------------------- */
+
+func TestDecExpDecLnInverse(t *testing.T) {
+	const prec int32 = 20
+	tolerance := decimal.New(1, -(prec - 5))
+
+	xs := []string{"0", "0.0001", "1", "-1", "2.5", "-7.25", "12", "-20.3"}
+	for _, xStr := range xs {
+		x := decimal.RequireFromString(xStr)
+		expX := DecExp(x, prec)
+		roundTripped := DecLn(expX, prec)
+		diff := roundTripped.Sub(x).Abs()
+		// DecExp rounds to prec decimal places, so a small e^x keeps fewer significant digits and
+		// DecLn can only recover x to about 10^-prec / e^x on top of the shared tolerance.
+		bound := tolerance.Add(decimal.New(1, -prec).DivRound(expX, prec))
+		if diff.GreaterThan(bound) {
+			t.Errorf("DecLn(DecExp(%s)) = %s, diff %s exceeds tolerance %s", xStr, roundTripped.String(), diff.String(), bound.String())
+		}
+	}
+
+	ms := []string{"0.01", "0.5", "1", "1.9999", "8", "1000"}
+	for _, mStr := range ms {
+		m := decimal.RequireFromString(mStr)
+		roundTripped := DecExp(DecLn(m, prec), prec)
+		diff := roundTripped.Sub(m).Abs()
+		if diff.GreaterThan(tolerance) {
+			t.Errorf("DecExp(DecLn(%s)) = %s, diff %s exceeds tolerance %s", mStr, roundTripped.String(), diff.String(), tolerance.String())
+		}
+	}
+}
+
+/* -------------------------------------------------------------------------------------------------
+TestExponentialSigmoidIntegralAdditivity: splitting a buy into two consecutive legs (supply -> mid,
+then mid -> supply+amount) and summing their cost must equal buying the whole amount in one call,
+to within the decimal precision DecExp/DecLn were asked to solve for. Under the old float64-backed
+Exponent/math.Log this kind of accumulated rounding was the first thing to drift on large supplies;
+this pins it the way a buy-then-sell round trip would pin spread loss, but without depending on
+selling_social_tokens' own supply bookkeeping.
+------------------------------------------------------------------------------------------------- */
+
+func TestExponentialSigmoidIntegralAdditivity(t *testing.T) {
+	precisionSlack := decimal.New(1, -(AMM_DECIMAL_PRECISION - 10))
+
+	cases := []struct {
+		amm            string
+		supplyReleased string
+		initialSupply  string
+		amount         string
+		targetPrice    string
+		targetSupply   string
+	}{
+		{EXPONENTIAL_AMM, "12", "2", "3", "1", "10"},
+		{SIGMOID_AMM, "120", "20", "10", "2", "100"},
+	}
+
+	for _, c := range cases {
+		supplyReleased := decimal.RequireFromString(c.supplyReleased)
+		initialSupply := decimal.RequireFromString(c.initialSupply)
+		amount := decimal.RequireFromString(c.amount)
+		targetPrice := decimal.RequireFromString(c.targetPrice)
+		targetSupply := decimal.RequireFromString(c.targetSupply)
+
+		wholeLegCost, err := buyingSocialTokens(c.amm, supplyReleased, initialSupply, amount, targetPrice, targetSupply)
+		if err != nil {
+			t.Fatalf("%s: buyingSocialTokens (whole leg): %s", c.amm, err.Error())
+		}
+
+		halfAmount := amount.Div(TWO_DECIMAL)
+		firstLegCost, err := buyingSocialTokens(c.amm, supplyReleased, initialSupply, halfAmount, targetPrice, targetSupply)
+		if err != nil {
+			t.Fatalf("%s: buyingSocialTokens (first leg): %s", c.amm, err.Error())
+		}
+		secondLegCost, err := buyingSocialTokens(c.amm, supplyReleased.Add(halfAmount), initialSupply, halfAmount, targetPrice, targetSupply)
+		if err != nil {
+			t.Fatalf("%s: buyingSocialTokens (second leg): %s", c.amm, err.Error())
+		}
+
+		splitCost := firstLegCost.Add(secondLegCost)
+		diff := splitCost.Sub(wholeLegCost).Abs()
+		if diff.GreaterThan(precisionSlack) {
+			t.Errorf("%s: split-leg cost %s diverges from whole-leg cost %s by %s, exceeds precision slack %s",
+				c.amm, splitCost.String(), wholeLegCost.String(), diff.String(), precisionSlack.String())
+		}
+	}
+}
@@ -10,11 +10,13 @@ package socialtoken
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
+	"strconv"
 
 	"github.com/Get-Cache/Privi/contracts/coinbalance"
+	"github.com/Get-Cache/Privi/utils"
 	"github.com/hyperledger/fabric/core/chaincode/shim"
 	pb "github.com/hyperledger/fabric/protos/peer"
+	"github.com/shopspring/decimal"
 )
 
 /* -------------------------------------------------------------------------------------------------
@@ -50,6 +52,19 @@ func GetSocialPoolInfo(stub shim.ChaincodeStubInterface,
 	if err != nil {
 		return pool, err
 	}
+
+	// Verified is a read-side projection of IndexVerifiedSocialPools, never trusted from the
+	// stored pool blob, so curation decisions can't be smuggled in through a stale write.
+	pool.Verified, err = isPoolVerified(stub, address)
+	if err != nil {
+		return pool, err
+	}
+	// GlobalLocked is likewise a read-side projection, of IndexGlobalLock, so clients see the
+	// chain-wide emergency pause here too, not just this pool's own Locked flag on SocialPoolState.
+	pool.GlobalLocked, err = isGloballyLocked(stub)
+	if err != nil {
+		return pool, err
+	}
 	return pool, nil
 }
 
@@ -109,6 +124,257 @@ func updateSocialTokenState(stub shim.ChaincodeStubInterface,
 	return nil
 }
 
+/* -------------------------------------------------------------------------------------------------
+isPoolVerified / setPoolVerified: read and write access to the curated verified-pools registry.
+                 Membership is tracked as one world-state key per pool rather than inside the
+                 SocialPool blob itself, so verifying/unverifying never touches, and can never be
+                 confused with, the pool's own data.
+------------------------------------------------------------------------------------------------- */
+
+func isPoolVerified(stub shim.ChaincodeStubInterface, address string) (bool, error) {
+	verifiedBytes, err := stub.GetState(IndexVerifiedSocialPools + address)
+	if err != nil {
+		return false, errors.New("ERROR: GETTING VERIFIED STATE OF POOL " + address + ". " + err.Error())
+	}
+	return verifiedBytes != nil, nil
+}
+
+func setPoolVerified(stub shim.ChaincodeStubInterface, address string, verified bool) error {
+	if !verified {
+		if err := stub.DelState(IndexVerifiedSocialPools + address); err != nil {
+			return errors.New("ERROR: UNVERIFYING POOL " + address + ". " + err.Error())
+		}
+		return nil
+	}
+	if err := stub.PutState(IndexVerifiedSocialPools+address, []byte("true")); err != nil {
+		return errors.New("ERROR: VERIFYING POOL " + address + ". " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+assertAdmin: this function returns an error unless the requester holds ADMIN_ROLE. Used to gate
+             curation of the verified-pools registry.
+------------------------------------------------------------------------------------------------- */
+
+func assertAdmin(stub shim.ChaincodeStubInterface, requester string) error {
+	actor, err := coinbalance.GetUser(stub, requester)
+	if err != nil {
+		return errors.New("ERROR: GETTING REQUESTER " + requester + ". " + err.Error())
+	}
+	if actor.Role != ADMIN_ROLE {
+		return errors.New("ERROR: " + requester + " IS NOT AUTHORIZED. REQUIRES ADMIN_ROLE.")
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+assertPoolAdmin: this function returns an error unless the requester is the pool Creator or holds
+                 ADMIN_ROLE. Used to gate halt/resume so incident response does not require the
+                 pool creator's key (e.g. a compromised creator key is exactly when ADMIN_ROLE
+                 needs to be able to halt the pool).
+------------------------------------------------------------------------------------------------- */
+
+func assertPoolAdmin(stub shim.ChaincodeStubInterface, pool SocialPool, requester string) error {
+	if requester == pool.Creator {
+		return nil
+	}
+	actor, err := coinbalance.GetUser(stub, requester)
+	if err != nil {
+		return errors.New("ERROR: GETTING REQUESTER " + requester + ". " + err.Error())
+	}
+	if actor.Role == ADMIN_ROLE {
+		return nil
+	}
+	return errors.New("ERROR: " + requester + " IS NOT AUTHORIZED TO HALT OR RESUME POOL " + pool.PoolAddress + ".")
+}
+
+/* -------------------------------------------------------------------------------------------------
+assertPoolActive: this function returns an error if the pool is currently halted. A halt with a
+                 non-zero HaltUntil automatically lifts once the chain's clock passes it; a halt
+                 with HaltUntil == 0 stays in effect until ResumeSocialPool is submitted.
+------------------------------------------------------------------------------------------------- */
+
+func assertPoolActive(stub shim.ChaincodeStubInterface, poolState SocialPoolState) error {
+	if !poolState.Halted {
+		return nil
+	}
+	if poolState.HaltUntil > 0 {
+		timestamp, err := stub.GetTxTimestamp()
+		if err != nil {
+			return errors.New("ERROR: GETTING TIMESTAMP OF THE TRANSACTION. " + err.Error())
+		}
+		if int64(timestamp.Seconds) >= poolState.HaltUntil {
+			return nil
+		}
+	}
+	return errors.New("ERROR: SOCIAL POOL IS HALTED.")
+}
+
+/* -------------------------------------------------------------------------------------------------
+ErrPoolLocked: this is the typed error assertPoolUnlocked returns so callers can distinguish an
+             emergency lock from any other failure without string-matching. PoolAddress is empty
+             when the lock in effect is the chain-wide one rather than this pool's own.
+------------------------------------------------------------------------------------------------- */
+
+type ErrPoolLocked struct {
+	PoolAddress string
+	Reason      string
+	Global      bool
+}
+
+func (e *ErrPoolLocked) Error() string {
+	if e.Global {
+		return "ERROR: SOCIAL TOKEN CHAINCODE IS GLOBALLY LOCKED. " + e.Reason
+	}
+	return "ERROR: SOCIAL POOL " + e.PoolAddress + " IS LOCKED. " + e.Reason
+}
+
+/* -------------------------------------------------------------------------------------------------
+isGloballyLocked / setGlobalLocked: read and write access to the chain-wide emergency pause flag.
+             Tracked as its own world-state key, like isPoolVerified/setPoolVerified, since it is
+             not scoped to any single pool.
+------------------------------------------------------------------------------------------------- */
+
+func isGloballyLocked(stub shim.ChaincodeStubInterface) (bool, error) {
+	lockedBytes, err := stub.GetState(IndexGlobalLock)
+	if err != nil {
+		return false, errors.New("ERROR: GETTING GLOBAL LOCK STATE. " + err.Error())
+	}
+	return lockedBytes != nil, nil
+}
+
+func setGlobalLocked(stub shim.ChaincodeStubInterface, locked bool) error {
+	if !locked {
+		if err := stub.DelState(IndexGlobalLock); err != nil {
+			return errors.New("ERROR: CLEARING GLOBAL LOCK STATE. " + err.Error())
+		}
+		return nil
+	}
+	if err := stub.PutState(IndexGlobalLock, []byte("true")); err != nil {
+		return errors.New("ERROR: SETTING GLOBAL LOCK STATE. " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+assertPoolUnlocked: this function returns an ErrPoolLocked unless functionName is allowed to proceed
+             given the chaincode's current lock state, without touching any state itself: the
+             chain-wide lock set by SetGlobalLock blocks every function with no exceptions, while a
+             pool's own Locked flag set by SetPoolLock blocks every function except the names listed
+             in that pool's AllowedWhileLocked (e.g. "sellSocialToken", so redemptions can keep
+             running).
+------------------------------------------------------------------------------------------------- */
+
+func assertPoolUnlocked(stub shim.ChaincodeStubInterface, poolState SocialPoolState, address string, functionName string) error {
+	globallyLocked, err := isGloballyLocked(stub)
+	if err != nil {
+		return err
+	}
+	if globallyLocked {
+		return &ErrPoolLocked{Global: true}
+	}
+	if !poolState.Locked {
+		return nil
+	}
+	for _, allowed := range poolState.AllowedWhileLocked {
+		if allowed == functionName {
+			return nil
+		}
+	}
+	return &ErrPoolLocked{PoolAddress: address, Reason: poolState.Reason}
+}
+
+/* -------------------------------------------------------------------------------------------------
+emitPoolLockEvent / emitGlobalLockEvent: best-effort chaincode events so off-chain observers can
+             react to a lock or unlock without polling GetSocialPoolState/GetSocialPoolInfo.
+------------------------------------------------------------------------------------------------- */
+
+func emitPoolLockEvent(stub shim.ChaincodeStubInterface, address string, poolState SocialPoolState, date int64) error {
+	eventBytes, err := json.Marshal(PoolLockChanged{
+		PoolAddress: address,
+		Locked:      poolState.Locked,
+		LockedBy:    poolState.LockedBy,
+		Reason:      poolState.Reason,
+		Date:        date,
+	})
+	if err != nil {
+		return errors.New("ERROR: GENERATING POOL LOCK EVENT. " + err.Error())
+	}
+	if err := stub.SetEvent(SOCIAL_TOKEN_POOL_LOCK_EVENT, eventBytes); err != nil {
+		return errors.New("ERROR: EMITTING POOL LOCK EVENT. " + err.Error())
+	}
+	return nil
+}
+
+func emitGlobalLockEvent(stub shim.ChaincodeStubInterface, locked bool, lockedBy string, reason string, date int64) error {
+	eventBytes, err := json.Marshal(GlobalLockChanged{
+		Locked:   locked,
+		LockedBy: lockedBy,
+		Reason:   reason,
+		Date:     date,
+	})
+	if err != nil {
+		return errors.New("ERROR: GENERATING GLOBAL LOCK EVENT. " + err.Error())
+	}
+	if err := stub.SetEvent(SOCIAL_TOKEN_GLOBAL_LOCK_EVENT, eventBytes); err != nil {
+		return errors.New("ERROR: EMITTING GLOBAL LOCK EVENT. " + err.Error())
+	}
+	return nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+effectiveSupplyReleased: this function returns the supply the AMM curve should price against,
+             folding in tokens currently bridged out to another TokenChain so the curve price
+             does not move just because some of the supply is temporarily living elsewhere.
+------------------------------------------------------------------------------------------------- */
+
+func effectiveSupplyReleased(poolState SocialPoolState) decimal.Decimal {
+	return poolState.SupplyReleased.Add(poolState.BridgedSupply)
+}
+
+/* -------------------------------------------------------------------------------------------------
+verifyRelayerQuorum: this function checks that at least `threshold` distinct EXCHANGE_ROLE
+             relayers attested to a bridge-in by actually verifying each one's signature over hash
+             (the same utils.VerifySignature primitive bridge/bridge.go's verifyAttestation uses for
+             the lockbox bridge), not merely checking that a same-length, non-empty string was
+             supplied. It returns an error whose message names the check that failed (mismatched arrays,
+             unknown/unauthorized relayer, a signature that fails verification, or insufficient
+             distinct attestations).
+------------------------------------------------------------------------------------------------- */
+
+func verifyRelayerQuorum(stub shim.ChaincodeStubInterface, relayers []string, signatures []string,
+	threshold int, hash string, payload []byte) error {
+	if len(relayers) != len(signatures) {
+		return errors.New("ERROR: RELAYERS AND SIGNATURES MUST HAVE THE SAME LENGTH.")
+	}
+	if len(relayers) < threshold {
+		return errors.New("ERROR: BRIDGE-IN REQUIRES AT LEAST " + strconv.Itoa(threshold) + " RELAYER ATTESTATIONS.")
+	}
+
+	attested := make(map[string]bool)
+	for i, relayer := range relayers {
+		if attested[relayer] {
+			continue
+		}
+		actor, err := coinbalance.GetUser(stub, relayer)
+		if err != nil {
+			return errors.New("ERROR: UNKNOWN RELAYER " + relayer + ". " + err.Error())
+		}
+		if actor.Role != EXCHANGE_ROLE {
+			return errors.New("ERROR: " + relayer + " IS NOT AN AUTHORIZED BRIDGE RELAYER.")
+		}
+		if err := utils.VerifySignature(stub, actor.PublicAddress, hash, signatures[i], payload); err != nil {
+			return errors.New("ERROR: VERIFYING ATTESTATION OF RELAYER " + relayer + ". " + err.Error())
+		}
+		attested[relayer] = true
+	}
+	if len(attested) < threshold {
+		return errors.New("ERROR: BRIDGE-IN REQUIRES " + strconv.Itoa(threshold) + " DISTINCT RELAYER ATTESTATIONS.")
+	}
+	return nil
+}
+
 /* -------------------------------------------------------------------------------------------------
 registerSocialToken: this function register a new social token in the system
 ------------------------------------------------------------------------------------------------- */
@@ -128,6 +394,15 @@ func registerSocialToken(stub shim.ChaincodeStubInterface, input SocialPool, add
 		return nil, []coinbalance.Transfer{}, err
 	}
 
+	// Index the pool by Creator and AMM curve type so ListSocialPoolsByCreator/ListSocialPoolsByTokenType
+	// don't need a rich query //
+	if err := addPoolToIndex(stub, IndexCreatorPools, input.Creator, address); err != nil {
+		return nil, []coinbalance.Transfer{}, err
+	}
+	if err := addPoolToIndex(stub, IndexAMMTypePools, input.AMM, address); err != nil {
+		return nil, []coinbalance.Transfer{}, err
+	}
+
 	return r.UpdateTokens, r.Transactions, nil
 }
 
@@ -136,10 +411,16 @@ registerAddress: this function register a balance
 ------------------------------------------------------------------------------------------------- */
 
 /* -------------------------------------------------------------------------------------------------
-multiTransfer: this function computes all the transfers taking place on the smart contract
+multiTransfer: this function computes all the transfers taking place on the smart contract. address/
+             functionName are checked against assertPoolUnlocked before any transfer is attempted, so
+             a locked pool's funds never move even if a caller forgets to check first.
 ------------------------------------------------------------------------------------------------- */
 
-func multiTransfer(stub shim.ChaincodeStubInterface, multitransfers ...coinbalance.TransferRequest) ([]coinbalance.Transfer, error) {
+func multiTransfer(stub shim.ChaincodeStubInterface, poolState SocialPoolState, address string,
+	functionName string, multitransfers ...coinbalance.TransferRequest) ([]coinbalance.Transfer, error) {
+	if err := assertPoolUnlocked(stub, poolState, address, functionName); err != nil {
+		return []coinbalance.Transfer{}, err
+	}
 	r, err := coinbalance.Multitransfer(stub, multitransfers...)
 	if err != nil {
 		return []coinbalance.Transfer{}, err
@@ -148,10 +429,15 @@ func multiTransfer(stub shim.ChaincodeStubInterface, multitransfers ...coinbalan
 }
 
 /* -------------------------------------------------------------------------------------------------
-mintSocialPoolTokens: this function mints pool tokens for a user
+mintSocialPoolTokens: this function mints pool tokens for a user, gated by assertPoolUnlocked like
+             multiTransfer above.
 ------------------------------------------------------------------------------------------------- */
 
-func mintSocialPoolTokens(stub shim.ChaincodeStubInterface, input *coinbalance.TransferRequest) ([]coinbalance.Transfer, error) {
+func mintSocialPoolTokens(stub shim.ChaincodeStubInterface, poolState SocialPoolState, address string,
+	functionName string, input *coinbalance.TransferRequest) ([]coinbalance.Transfer, error) {
+	if err := assertPoolUnlocked(stub, poolState, address, functionName); err != nil {
+		return []coinbalance.Transfer{}, err
+	}
 	r, err := coinbalance.Mint(stub, input)
 	if err != nil {
 		return []coinbalance.Transfer{}, err
@@ -160,10 +446,15 @@ func mintSocialPoolTokens(stub shim.ChaincodeStubInterface, input *coinbalance.T
 }
 
 /* -------------------------------------------------------------------------------------------------
-burnSocialPoolTokens: this function mints pool tokens for a user
+burnSocialPoolTokens: this function burns pool tokens for a user, gated by assertPoolUnlocked like
+             multiTransfer above.
 ------------------------------------------------------------------------------------------------- */
 
-func burnSocialPoolTokens(stub shim.ChaincodeStubInterface, input *coinbalance.TransferRequest) ([]coinbalance.Transfer, error) {
+func burnSocialPoolTokens(stub shim.ChaincodeStubInterface, poolState SocialPoolState, address string,
+	functionName string, input *coinbalance.TransferRequest) ([]coinbalance.Transfer, error) {
+	if err := assertPoolUnlocked(stub, poolState, address, functionName); err != nil {
+		return []coinbalance.Transfer{}, err
+	}
 	r, err := coinbalance.Burn(stub, input)
 	if err != nil {
 		return []coinbalance.Transfer{}, err
@@ -176,26 +467,116 @@ getSocialPoolOfToken: returns the social pool address given the token symbol
 ------------------------------------------------------------------------------------------------- */
 
 func GetSocialPoolOfToken(stub shim.ChaincodeStubInterface,
-	tokenSymbol string) (string, error) {
-	queryString := fmt.Sprintf(`{"selector":{"TokenSymbol":"%s"}}`, tokenSymbol)
-	it, err := stub.GetQueryResult(queryString)
-	if err != nil {
-		return "", errors.New("ERROR: unable to get an iterator over the social tokens.")
-	}
-	defer it.Close()
-	pool := SocialPool{}
-	for it.HasNext() {
-		response, error := it.Next()
-		if error != nil {
-			message := fmt.Sprintf("unable to get the next element: %s", error.Error())
-			return "", errors.New(message)
-		}
-		if err = json.Unmarshal(response.Value, &pool); err != nil {
-			message := fmt.Sprintf("ERROR: unable to parse the response: %s", err.Error())
-			return "", errors.New(message)
+	ref string) (string, error) {
+	tokenIDs, err := resolveTokenIDs(stub, ref)
+	if err != nil {
+		return "", err
+	}
+	if len(tokenIDs) == 0 {
+		return "", errors.New("ERROR: NO SOCIAL TOKEN FOUND FOR " + ref + ".")
+	}
+	if len(tokenIDs) > 1 {
+		return "", errors.New("ERROR: " + ref + " IS AMBIGUOUS, MATCHES MULTIPLE TOKEN IDS.")
+	}
+	return GetSocialPoolOfTokenID(stub, tokenIDs[0])
+}
+
+/* -------------------------------------------------------------------------------------------------
+nextTokenID: this function hands out the next TokenID in the monotonic sequence CreateSocialToken
+             assigns pools from, so TokenSymbol collisions can never collide on identity too.
+------------------------------------------------------------------------------------------------- */
+
+func nextTokenID(stub shim.ChaincodeStubInterface) (uint64, error) {
+	var lastID uint64
+	data, err := stub.GetState(IndexTokenIDCounter)
+	if err != nil {
+		return 0, errors.New("ERROR: GETTING TOKEN ID COUNTER. " + err.Error())
+	}
+	if data != nil {
+		lastID, err = strconv.ParseUint(string(data), 10, 64)
+		if err != nil {
+			return 0, errors.New("ERROR: PARSING TOKEN ID COUNTER. " + err.Error())
 		}
 	}
-	return pool.PoolAddress, nil
+	nextID := lastID + 1
+	if err := stub.PutState(IndexTokenIDCounter, []byte(strconv.FormatUint(nextID, 10))); err != nil {
+		return 0, errors.New("ERROR: STORING TOKEN ID COUNTER. " + err.Error())
+	}
+	return nextID, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+putTokenIDPool / GetSocialPoolOfTokenID: maintain the TokenID -> current PoolAddress index. Repointed
+             by recreateSocialToken so a retired pool's TokenID lineage resolves to its replacement.
+------------------------------------------------------------------------------------------------- */
+
+func putTokenIDPool(stub shim.ChaincodeStubInterface, tokenID uint64, poolAddress string) error {
+	key := IndexTokenIDToPool + strconv.FormatUint(tokenID, 10)
+	if err := stub.PutState(key, []byte(poolAddress)); err != nil {
+		return errors.New("ERROR: STORING POOL ADDRESS OF TOKEN ID " + strconv.FormatUint(tokenID, 10) + ". " + err.Error())
+	}
+	return nil
+}
+
+func GetSocialPoolOfTokenID(stub shim.ChaincodeStubInterface, tokenID uint64) (string, error) {
+	key := IndexTokenIDToPool + strconv.FormatUint(tokenID, 10)
+	data, err := stub.GetState(key)
+	if err != nil {
+		return "", errors.New("ERROR: GETTING POOL ADDRESS OF TOKEN ID " + strconv.FormatUint(tokenID, 10) + ". " + err.Error())
+	}
+	if data == nil {
+		return "", errors.New("ERROR: NO SOCIAL TOKEN FOUND FOR TOKEN ID " + strconv.FormatUint(tokenID, 10) + ".")
+	}
+	return string(data), nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+addTokenIDToSymbolIndex / GetTokenIDsBySymbol: maintain the symbol -> []TokenID reverse index, which
+             tolerates multiple pools registering the same display TokenSymbol.
+------------------------------------------------------------------------------------------------- */
+
+func addTokenIDToSymbolIndex(stub shim.ChaincodeStubInterface, symbol string, tokenID uint64) error {
+	tokenIDs, err := GetTokenIDsBySymbol(stub, symbol)
+	if err != nil {
+		return err
+	}
+	tokenIDs = append(tokenIDs, tokenID)
+	data, err := json.Marshal(tokenIDs)
+	if err != nil {
+		return errors.New("ERROR: ENCODING TOKEN IDS OF SYMBOL " + symbol + ". " + err.Error())
+	}
+	if err := stub.PutState(IndexSymbolTokenIDs+symbol, data); err != nil {
+		return errors.New("ERROR: STORING TOKEN IDS OF SYMBOL " + symbol + ". " + err.Error())
+	}
+	return nil
+}
+
+func GetTokenIDsBySymbol(stub shim.ChaincodeStubInterface, symbol string) ([]uint64, error) {
+	data, err := stub.GetState(IndexSymbolTokenIDs + symbol)
+	if err != nil {
+		return nil, errors.New("ERROR: GETTING TOKEN IDS OF SYMBOL " + symbol + ". " + err.Error())
+	}
+	if data == nil {
+		return []uint64{}, nil
+	}
+	var tokenIDs []uint64
+	if err := json.Unmarshal(data, &tokenIDs); err != nil {
+		return nil, errors.New("ERROR: PARSING TOKEN IDS OF SYMBOL " + symbol + ". " + err.Error())
+	}
+	return tokenIDs, nil
+}
+
+/* -------------------------------------------------------------------------------------------------
+resolveTokenIDs: this function resolves a lookup ref that may be either a TokenID or a TokenSymbol
+             into the set of matching TokenIDs: a single element for an ID or an unambiguous symbol,
+             zero for an unknown ref, or more than one for a symbol shared by several pools.
+------------------------------------------------------------------------------------------------- */
+
+func resolveTokenIDs(stub shim.ChaincodeStubInterface, ref string) ([]uint64, error) {
+	if tokenID, err := strconv.ParseUint(ref, 10, 64); err == nil {
+		return []uint64{tokenID}, nil
+	}
+	return GetTokenIDsBySymbol(stub, ref)
 }
 
 /* -------------------------------------------------------------------------------------------------
@@ -206,7 +587,8 @@ func generateOutput(
 	pools map[string]SocialPool,
 	poolStates map[string]SocialPoolState,
 	tokens map[string]coinbalance.Token,
-	transactions []coinbalance.Transfer) pb.Response {
+	transactions []coinbalance.Transfer,
+	verifiedPools map[string]bool) pb.Response {
 
 	// Output object //
 	output := Output{
@@ -214,6 +596,7 @@ func generateOutput(
 		UpdateSocialPoolStates: poolStates,
 		UpdateTokens:           tokens,
 		Transactions:           transactions,
+		VerifiedPools:          verifiedPools,
 	}
 	outputBytes, err := json.Marshal(output)
 
@@ -223,5 +606,37 @@ func generateOutput(
 	return shim.Success(outputBytes)
 }
 
+/* -------------------------------------------------------------------------------------------------
+emitTradeEvent: this function sets a SOCIAL_TOKEN_TRADE_EVENT chaincode event carrying the price the
+             curve lands on after a buy/sell, so off-chain indexers can reconstruct trade history
+             without re-reading state.
+------------------------------------------------------------------------------------------------- */
+
+func emitTradeEvent(stub shim.ChaincodeStubInterface, pool SocialPool, poolState SocialPoolState,
+	investor string, side string, amount decimal.Decimal, fundingAmount decimal.Decimal, date int64) error {
+
+	price, err := getMarketPrice(pool.AMM, effectiveSupplyReleased(poolState), pool.InitialSupply,
+		pool.TargetPrice, pool.TargetSupply)
+	if err != nil {
+		return err
+	}
+	eventBytes, err := json.Marshal(TradeExecuted{
+		PoolAddress:   pool.PoolAddress,
+		Investor:      investor,
+		Side:          side,
+		Amount:        amount,
+		FundingAmount: fundingAmount,
+		Price:         price,
+		Date:          date,
+	})
+	if err != nil {
+		return errors.New("ERROR: GENERATING TRADE EVENT. " + err.Error())
+	}
+	if err := stub.SetEvent(SOCIAL_TOKEN_TRADE_EVENT, eventBytes); err != nil {
+		return errors.New("ERROR: EMITTING TRADE EVENT. " + err.Error())
+	}
+	return nil
+}
+
 /* ------------------------------------------------------------------------------------------------
 ------------------------------------------------------------------------------------------------- */
\ No newline at end of file
@@ -16,6 +16,20 @@ type Output struct {
 	UpdateSocialPoolStates map[string]SocialPoolState   `json:"UpdateSocialPoolStates"`
 	UpdateTokens           map[string]coinbalance.Token `json:"UpdateTokens"`
 	Transactions           []coinbalance.Transfer       `json:"Transactions"`
+	// VerifiedPools lets clients tell curator-endorsed social tokens from arbitrary user-created
+	// ones without a side channel, keyed by PoolAddress.
+	VerifiedPools map[string]bool `json:"VerifiedPools"`
+}
+
+// PaginatedOutput is returned by ListSocialPools/ListSocialPoolStates instead of Output, so a client
+// enumerating every pool on the chain can page through stub.GetStateByRangeWithPagination's results
+// rather than forcing generateOutput to marshal the entire pool set in one response. Only one of
+// Pools/PoolStates is populated per call, matching which list function was invoked.
+type PaginatedOutput struct {
+	Pools          []SocialPool               `json:"Pools,omitempty"`
+	PoolStates     map[string]SocialPoolState `json:"PoolStates,omitempty"`
+	NextBookmark   string                     `json:"NextBookmark"`
+	FetchedRecords int32                      `json:"FetchedRecords"`
 }
 
 /*---------------------------------------------------------------------------
@@ -24,9 +38,13 @@ SMART CONTRACT MODELS FOR SOCIAL TOKENS
 
 // Define model of Social Token creation //
 type SocialPool struct {
-	Creator        string          `json:"Creator"`
-	PoolAddress    string          `json:"PoolAddress"`
-	AMM            string          `json:"AMM"`
+	Creator     string `json:"Creator"`
+	PoolAddress string `json:"PoolAddress"`
+	AMM         string `json:"AMM"`
+	// TokenID is the pool's permanent primary key, assigned once at creation by nextTokenID and
+	// never reused; TokenSymbol is display-only and may collide across pools, see
+	// IndexSymbolTokenIDs and resolveTokenIDs.
+	TokenID        uint64          `json:"TokenID"`
 	SpreadDividend decimal.Decimal `json:"SpreadDividend"`
 	TokenSymbol    string          `json:"TokenSymbol"`
 	TokenName      string          `json:"TokenName"`
@@ -38,12 +56,97 @@ type SocialPool struct {
 	TargetPrice    decimal.Decimal `json:"TargetPrice"`
 	TokenChain     string          `json:"TokenChain"`
 	Date           int64           `json:"Date"`
+	// Verified is never trusted from storage: GetSocialPoolInfo always overwrites it from
+	// IndexVerifiedSocialPools, so it reflects the curator's current decision on every read.
+	Verified bool `json:"Verified"`
+	// GlobalLocked is likewise never trusted from storage: GetSocialPoolInfo always overwrites it
+	// from IndexGlobalLock, so clients can render a paused-pool UI even when the pause is chain-wide
+	// rather than this pool's own Locked flag on SocialPoolState.
+	GlobalLocked bool `json:"GlobalLocked"`
+	// Hash/Signature/Timestamp/Nonce authenticate Creator's request to assertValidSecureRequest;
+	// see Security.go. They are stored with the rest of the pool.
+	Hash      string `json:"Hash"`
+	Signature string `json:"Signature"`
+	Timestamp int64  `json:"Timestamp"`
+	Nonce     int64  `json:"Nonce"`
+	// SupersededBy is set on a retired pool by recreateSocialToken, pointing at the PoolAddress of
+	// the new pool now carrying this pool's TokenID lineage. Empty for a pool that is still current.
+	SupersededBy string `json:"SupersededBy"`
+	// Operators/Threshold configure an M-of-N operator quorum: SubmitSignedOp/ProposeOp/CoSignOp
+	// run registerSocialToken, mintSocialPoolTokens, burnSocialPoolTokens, or updateSocialPoolInfo
+	// once Threshold distinct Operators have signed, each signature checked on its own through
+	// utils.VerifySignature (no BLS aggregate is verified). The quorum is an extra path only: the
+	// other handlers (CreateSocialToken, ModifySocialPool, buy/sell, bridging, dividends) still
+	// call those helpers directly, with no operator signatures. Threshold == 0 means the pool has no operator quorum
+	// configured, so SubmitSignedOp is unavailable for it. See MultiSig.go and socialtoken/auth.
+	Operators []OperatorKey `json:"Operators"`
+	Threshold int           `json:"Threshold"`
+}
+
+// OperatorKey is one of a SocialPool's pre-registered multisig signers: Operator is the identity
+// utils.VerifySignature resolves a signature against (the same identity model every other secure
+// request in this chaincode uses), PublicKey is the BLS public key material Operator attests to
+// off-chain, kept for audit and for reuse once socialtoken/auth verifies real BLS aggregates rather
+// than per-signer signatures.
+type OperatorKey struct {
+	Operator  string `json:"Operator"`
+	PublicKey string `json:"PublicKey"`
 }
 
 // Define model of the state of a POD //
 type SocialPoolState struct {
 	SupplyReleased decimal.Decimal `json:"SupplyReleased"`
 	DividendFunds  decimal.Decimal `json:"DividendFunds"`
+	Halted         bool            `json:"Halted"`
+	HaltUntil      int64           `json:"HaltUntil"`
+	// BridgedSupply is the amount of this pool's supply currently bridged out to another
+	// TokenChain. It keeps effectiveSupplyReleased (and therefore the curve price) unchanged
+	// across a bridge-out/bridge-in round trip, even though SupplyReleased itself moves.
+	BridgedSupply decimal.Decimal `json:"BridgedSupply"`
+	// LastDividendDate is the timestamp the DividendFunds pot was last swept by
+	// DistributeSocialDividends. Per-holder dividend weight is only counted for time held since
+	// this date (or since the holder's own snapshot, whichever is later).
+	LastDividendDate int64 `json:"LastDividendDate"`
+	// Locked/LockedBy/LockedAt/Reason are the pool-scoped emergency pause set by SetPoolLock, for
+	// incident response that is stricter than haltSocialPool's buy/sell-only stop: a locked pool
+	// also blocks multiTransfer/mint/burn, except for the function names listed in
+	// AllowedWhileLocked (e.g. "sellSocialToken", so redemptions can still run during an incident).
+	Locked             bool     `json:"Locked"`
+	LockedBy           string   `json:"LockedBy"`
+	LockedAt           int64    `json:"LockedAt"`
+	Reason             string   `json:"Reason"`
+	AllowedWhileLocked []string `json:"AllowedWhileLocked"`
+}
+
+// DividendSnapshot is the per-holder checkpoint DistributeSocialDividends and ClaimSocialDividend
+// use to prorate a holder's share of DividendFunds by how long they have held their current
+// balance, so a mid-epoch buy or sell cannot claim time it was not actually held for.
+type DividendSnapshot struct {
+	PoolAddress string          `json:"PoolAddress"`
+	Holder      string          `json:"Holder"`
+	Balance     decimal.Decimal `json:"Balance"`
+	SinceDate   int64           `json:"SinceDate"`
+	LastClaim   int64           `json:"LastClaim"`
+}
+
+// Define instance of a keeper/creator-triggered sweep of a pool's dividend pot to all holders //
+type DistributeSocialDividendsRequest struct {
+	Requester   string `json:"Requester"`
+	PoolAddress string `json:"PoolAddress"`
+	Hash        string `json:"Hash"`
+	Signature   string `json:"Signature"`
+	Timestamp   int64  `json:"Timestamp"`
+	Nonce       int64  `json:"Nonce"`
+}
+
+// Define instance of a holder pulling their own pro-rata share of a pool's dividend pot //
+type ClaimSocialDividendRequest struct {
+	Requester   string `json:"Requester"`
+	PoolAddress string `json:"PoolAddress"`
+	Hash        string `json:"Hash"`
+	Signature   string `json:"Signature"`
+	Timestamp   int64  `json:"Timestamp"`
+	Nonce       int64  `json:"Nonce"`
 }
 
 // Define instance of a buying the social token on Blockchain //
@@ -53,6 +156,14 @@ type BuySocialToken struct {
 	Amount      decimal.Decimal `json:"Amount"`
 	Hash        string          `json:"Hash"`
 	Signature   string          `json:"Signature"`
+	Timestamp   int64           `json:"Timestamp"`
+	Nonce       int64           `json:"Nonce"`
+	// MaxFundingIn caps how much FundingToken the investor will pay for Amount; the buy aborts
+	// if the curve's quoted fundingAmount exceeds it. Zero means no cap.
+	MaxFundingIn decimal.Decimal `json:"MaxFundingIn"`
+	// Deadline is a tx-timestamp cutoff (seconds since epoch) after which the buy aborts rather
+	// than execute against a curve state the investor no longer quoted against. Zero means no cutoff.
+	Deadline int64 `json:"Deadline"`
 }
 
 // Define instance of a buying the social token on Blockchain //
@@ -62,6 +173,45 @@ type SellSocialToken struct {
 	Amount      decimal.Decimal `json:"Amount"`
 	Hash        string          `json:"Hash"`
 	Signature   string          `json:"Signature"`
+	Timestamp   int64           `json:"Timestamp"`
+	Nonce       int64           `json:"Nonce"`
+	// MinFundingOut floors how much FundingToken the investor will accept for Amount; the sell
+	// aborts if the curve's quoted fundingAmount falls short of it. Zero means no floor.
+	MinFundingOut decimal.Decimal `json:"MinFundingOut"`
+	// Deadline is a tx-timestamp cutoff (seconds since epoch) after which the sell aborts rather
+	// than execute against a curve state the investor no longer quoted against. Zero means no cutoff.
+	Deadline int64 `json:"Deadline"`
+}
+
+// TradeExecuted is the chaincode event payload emitted by MakeBuySocialToken/MakeSellSocialToken so
+// off-chain indexers can reconstruct trade history (price, size, direction) without re-reading state.
+type TradeExecuted struct {
+	PoolAddress   string          `json:"PoolAddress"`
+	Investor      string          `json:"Investor"`
+	Side          string          `json:"Side"`
+	Amount        decimal.Decimal `json:"Amount"`
+	FundingAmount decimal.Decimal `json:"FundingAmount"`
+	Price         decimal.Decimal `json:"Price"`
+	Date          int64           `json:"Date"`
+}
+
+// PoolLockChanged is the chaincode event payload emitted by SetPoolLock so off-chain observers can
+// react to a pool-scoped emergency pause or its lift without polling GetSocialPoolState.
+type PoolLockChanged struct {
+	PoolAddress string `json:"PoolAddress"`
+	Locked      bool   `json:"Locked"`
+	LockedBy    string `json:"LockedBy"`
+	Reason      string `json:"Reason"`
+	Date        int64  `json:"Date"`
+}
+
+// GlobalLockChanged is the chaincode event payload emitted by SetGlobalLock, mirroring
+// PoolLockChanged for the chain-wide lock rather than a single pool.
+type GlobalLockChanged struct {
+	Locked   bool   `json:"Locked"`
+	LockedBy string `json:"LockedBy"`
+	Reason   string `json:"Reason"`
+	Date     int64  `json:"Date"`
 }
 
 // Definition of Price By Symbol in Blockchain //
@@ -70,7 +220,233 @@ type PriceBySymbol struct {
 	Price        decimal.Decimal `json:"Price"`
 }
 
+// TokenIDAmbiguous is returned by getSocialTokenPriceBySymbol in place of a price when the lookup
+// ref is a symbol matching more than one TokenID, so the caller can disambiguate and re-query by ID.
+type TokenIDAmbiguous struct {
+	Symbol   string   `json:"Symbol"`
+	TokenIDs []uint64 `json:"TokenIDs"`
+}
+
+// Define instance of a request to retire a pool and create a replacement pool inheriting its
+// TokenID lineage, e.g. after a rename or a migration off a misconfigured AMM //
+type RecreateSocialTokenRequest struct {
+	Requester      string          `json:"Requester"`
+	OldPoolAddress string          `json:"OldPoolAddress"`
+	AMM            string          `json:"AMM"`
+	SpreadDividend decimal.Decimal `json:"SpreadDividend"`
+	TokenSymbol    string          `json:"TokenSymbol"`
+	TokenName      string          `json:"TokenName"`
+	InitialSupply  decimal.Decimal `json:"InitialSupply"`
+	FundingToken   string          `json:"FundingToken"`
+	DividendFreq   string          `json:"DividendFreq"`
+	LockUpDate     int64           `json:"LockUpDate"`
+	TargetSupply   decimal.Decimal `json:"TargetSupply"`
+	TargetPrice    decimal.Decimal `json:"TargetPrice"`
+	TokenChain     string          `json:"TokenChain"`
+	Hash           string          `json:"Hash"`
+	Signature      string          `json:"Signature"`
+	Timestamp      int64           `json:"Timestamp"`
+	Nonce          int64           `json:"Nonce"`
+}
+
+// Define instance of a request to transfer a pool's Creator rights to a new address //
+type ChangeSocialTokenOwnerRequest struct {
+	Requester   string `json:"Requester"`
+	PoolAddress string `json:"PoolAddress"`
+	NewOwner    string `json:"NewOwner"`
+	Hash        string `json:"Hash"`
+	Signature   string `json:"Signature"`
+	Timestamp   int64  `json:"Timestamp"`
+	Nonce       int64  `json:"Nonce"`
+}
+
 type ModifySocialPoolRequest struct {
-	Address      string `json:"Address"`
-	FundingToken string `json:"FundingToken"`
+	Requester    string          `json:"Requester"`
+	Address      string          `json:"Address"`
+	FundingToken string          `json:"FundingToken"`
+	AMM          string          `json:"AMM"`
+	TargetPrice  decimal.Decimal `json:"TargetPrice"`
+	TargetSupply decimal.Decimal `json:"TargetSupply"`
+	Hash         string          `json:"Hash"`
+	Signature    string          `json:"Signature"`
+	Timestamp    int64           `json:"Timestamp"`
+	Nonce        int64           `json:"Nonce"`
+}
+
+// Define instance of a request to curate the verified-pools registry //
+type VerifySocialPoolRequest struct {
+	Requester   string `json:"Requester"`
+	PoolAddress string `json:"PoolAddress"`
+	Hash        string `json:"Hash"`
+	Signature   string `json:"Signature"`
+	Timestamp   int64  `json:"Timestamp"`
+	Nonce       int64  `json:"Nonce"`
+}
+
+// Define instance of a request to rebuild IndexCreatorPools/IndexAMMTypePools from scratch //
+type RebuildIndexesRequest struct {
+	Requester string `json:"Requester"`
+	Hash      string `json:"Hash"`
+	Signature string `json:"Signature"`
+	Timestamp int64  `json:"Timestamp"`
+	Nonce     int64  `json:"Nonce"`
+}
+
+// Define instance of a request to halt buying/selling against a Social Pool //
+type HaltSocialPoolRequest struct {
+	Requester   string `json:"Requester"`
+	PoolAddress string `json:"PoolAddress"`
+	// Duration, in seconds from the transaction timestamp, after which the halt automatically
+	// lifts. Zero means the pool stays halted until a matching ResumeSocialPool is submitted.
+	Duration  int64  `json:"Duration"`
+	Hash      string `json:"Hash"`
+	Signature string `json:"Signature"`
+	Timestamp int64  `json:"Timestamp"`
+	Nonce     int64  `json:"Nonce"`
+}
+
+// Define instance of a request to resume buying/selling against a halted Social Pool //
+type ResumeSocialPoolRequest struct {
+	Requester   string `json:"Requester"`
+	PoolAddress string `json:"PoolAddress"`
+	Hash        string `json:"Hash"`
+	Signature   string `json:"Signature"`
+	Timestamp   int64  `json:"Timestamp"`
+	Nonce       int64  `json:"Nonce"`
+}
+
+// Define instance of a request to set or clear the emergency lock on a single Social Pool //
+type SetPoolLockRequest struct {
+	Requester   string `json:"Requester"`
+	PoolAddress string `json:"PoolAddress"`
+	Locked      bool   `json:"Locked"`
+	Reason      string `json:"Reason"`
+	// AllowedWhileLocked is only applied when Locked is true; it replaces whatever allowlist the
+	// pool previously had, it does not merge with it.
+	AllowedWhileLocked []string `json:"AllowedWhileLocked"`
+	Hash               string   `json:"Hash"`
+	Signature          string   `json:"Signature"`
+	Timestamp          int64    `json:"Timestamp"`
+	Nonce              int64    `json:"Nonce"`
+}
+
+// Define instance of a request to set or clear the chain-wide emergency lock //
+type SetGlobalLockRequest struct {
+	Requester string `json:"Requester"`
+	Locked    bool   `json:"Locked"`
+	Reason    string `json:"Reason"`
+	Hash      string `json:"Hash"`
+	Signature string `json:"Signature"`
+	Timestamp int64  `json:"Timestamp"`
+	Nonce     int64  `json:"Nonce"`
+}
+
+// PendingSignedOp is what ProposeOp creates and CoSignOp appends signatures to, letting a pool's
+// Operators accumulate a threshold of signatures over several transactions when they cannot
+// coordinate off-chain before submitting. Once len(Signers) reaches the pool's Threshold, CoSignOp
+// dispatches Op itself rather than waiting for a separate SubmitSignedOp call.
+type PendingSignedOp struct {
+	Op          string   `json:"Op"`
+	PoolAddress string   `json:"PoolAddress"`
+	Payload     string   `json:"Payload"`
+	Nonce       int64    `json:"Nonce"`
+	Signers     []string `json:"Signers"`
+	Signatures  []string `json:"Signatures"`
+}
+
+// Define instance of a fully-signed privileged operation submitted in one transaction, once the
+// caller already collected threshold signatures off-chain //
+type SubmitSignedOpRequest struct {
+	Op          string   `json:"Op"`
+	PoolAddress string   `json:"PoolAddress"`
+	Payload     string   `json:"Payload"`
+	Nonce       int64    `json:"Nonce"`
+	Signers     []string `json:"Signers"`
+	Signatures  []string `json:"Signatures"`
+}
+
+// Define instance of the first signature on a privileged operation, opening a PendingSignedOp for
+// other Operators to co-sign via CoSignOp //
+type ProposeOpRequest struct {
+	Proposer    string `json:"Proposer"`
+	Op          string `json:"Op"`
+	PoolAddress string `json:"PoolAddress"`
+	Payload     string `json:"Payload"`
+	Nonce       int64  `json:"Nonce"`
+	Signature   string `json:"Signature"`
+}
+
+// Define instance of an additional Operator co-signing an already-proposed PendingSignedOp //
+type CoSignOpRequest struct {
+	Signer      string `json:"Signer"`
+	PoolAddress string `json:"PoolAddress"`
+	Nonce       int64  `json:"Nonce"`
+	Signature   string `json:"Signature"`
+}
+
+// Define instance of bridging social tokens out to another TokenChain //
+type BridgeOutSocialToken struct {
+	Investor           string          `json:"Investor"`
+	PoolAddress        string          `json:"PoolAddress"`
+	Amount             decimal.Decimal `json:"Amount"`
+	DestinationChain   string          `json:"DestinationChain"`
+	DestinationAddress string          `json:"DestinationAddress"`
+	Hash               string          `json:"Hash"`
+	Signature          string          `json:"Signature"`
+	Timestamp          int64           `json:"Timestamp"`
+	Nonce              int64           `json:"Nonce"`
+}
+
+// Define instance of a request to lock social tokens here and mint a wrapped representation on an
+// external, non-Fabric chain via the socialtoken/bridge package //
+type InitiateBridgeOutRequest struct {
+	Requester          string          `json:"Requester"`
+	PoolAddress        string          `json:"PoolAddress"`
+	Amount             decimal.Decimal `json:"Amount"`
+	DestinationChain   string          `json:"DestinationChain"`
+	DestinationAddress string          `json:"DestinationAddress"`
+	Hash               string          `json:"Hash"`
+	Signature          string          `json:"Signature"`
+	Timestamp          int64           `json:"Timestamp"`
+	Nonce              int64           `json:"Nonce"`
+}
+
+// Define instance of a request to mint tokens here against an M-of-N relayer quorum's attestation
+// that the wrapped representation was locked/burned on SourceChain //
+type CompleteBridgeInRequest struct {
+	PoolAddress string          `json:"PoolAddress"`
+	SourceChain string          `json:"SourceChain"`
+	SourceTx    string          `json:"SourceTx"`
+	Recipient   string          `json:"Recipient"`
+	Amount      decimal.Decimal `json:"Amount"`
+	Hash        string          `json:"Hash"`
+	// Relayers/Signatures are parallel arrays: Signatures[i] is Relayers[i]'s attestation over Hash.
+	Relayers   []string `json:"Relayers"`
+	Signatures []string `json:"Signatures"`
+}
+
+// Define instance of an ADMIN_ROLE request to authorize the relayer set trusted for bridging a
+// given external chain //
+type SetChainRelayersRequest struct {
+	Requester string   `json:"Requester"`
+	Chain     string   `json:"Chain"`
+	Relayers  []string `json:"Relayers"`
+	Threshold int      `json:"Threshold"`
+	Hash      string   `json:"Hash"`
+	Signature string   `json:"Signature"`
+	Timestamp int64    `json:"Timestamp"`
+	Nonce     int64    `json:"Nonce"`
+}
+
+// Define instance of re-hydrating previously bridged-out social tokens on this chain //
+type BridgeInSocialToken struct {
+	Investor      string          `json:"Investor"`
+	PoolAddress   string          `json:"PoolAddress"`
+	Amount        decimal.Decimal `json:"Amount"`
+	SourceChain   string          `json:"SourceChain"`
+	SourceAddress string          `json:"SourceAddress"`
+	Hash          string          `json:"Hash"`
+	// Relayers/Signatures are parallel arrays: Signatures[i] is Relayers[i]'s attestation over Hash.
+	Relayers   []string `json:"Relayers"`
+	Signatures []string `json:"Signatures"`
 }
\ No newline at end of file
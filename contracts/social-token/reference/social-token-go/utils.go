@@ -88,6 +88,13 @@ func stringInSlice(a string, list []string) bool {
 	return false
 }
 
+// isParameterCurve reports whether amm repurposes TargetPrice/TargetSupply as its own curve
+// parameters (x0/y0, or the LMSR liquidity/scale factor) rather than a closed-form price target,
+// per ConstantProductCurve/LMSRCurve's own doc comments in the amm subpackage.
+func isParameterCurve(amm string) bool {
+	return amm == CONSTANT_PRODUCT_AMM || amm == LMSR_AMM
+}
+
 func saveSubstraction(main decimal.Decimal, amount decimal.Decimal) (decimal.Decimal, error) {
 	main = main.Sub(amount)
 	if main.IsNegative() {
@@ -137,6 +144,10 @@ func getUniqueAddress(input []byte, currentTime int64) string {
 /* -------------------------------------------------------------------------------------------------
 -------------------------------------------------------------------------------------------------*/
 
+// Deprecated: Exponent round-trips through float64 and silently caps precision at ~15 significant
+// digits. For the EXPONENTIAL and SIGMOID curves use DecExp/DecLn instead, which stay in decimal
+// arithmetic throughout. Kept only for callers outside the AMM curve math that still want a quick
+// float-backed power.
 func Exponent(base decimal.Decimal, exponent decimal.Decimal) decimal.Decimal {
 
 	f1, _ := base.Float64()
@@ -145,5 +156,90 @@ func Exponent(base decimal.Decimal, exponent decimal.Decimal) decimal.Decimal {
 	return decimal.NewFromFloat(math.Pow(f1, f2))
 }
 
+// ln2Decimal is ln(2) computed ahead of time to more digits than any AMM_DECIMAL_PRECISION caller
+// asks for; DecExp/DecLn range-reduce against it instead of taking a log at call time.
+var ln2Decimal = decimal.RequireFromString("0.69314718055994530941723212145817656807550013436025525412068000949339362196")
+
+/* -------------------------------------------------------------------------------------------------
+DecExp: computes e^x to prec decimal digits without ever leaving decimal.Decimal. x is range-reduced
+to x = k*ln2 + r with |r| <= ln2/2, e^r is summed from its Taylor series until the next term is
+smaller than 10^-prec, and the result is rescaled by the (exact) power of two 2^k.
+------------------------------------------------------------------------------------------------- */
+
+func DecExp(x decimal.Decimal, prec int32) decimal.Decimal {
+	workingPrec := prec + 10
+	halfLn2 := ln2Decimal.Div(TWO_DECIMAL)
+
+	k := x.DivRound(ln2Decimal, workingPrec).Round(0)
+	r := x.Sub(k.Mul(ln2Decimal))
+	for r.GreaterThan(halfLn2) {
+		k = k.Add(ONE_DECIMAL)
+		r = x.Sub(k.Mul(ln2Decimal))
+	}
+	for r.LessThan(halfLn2.Neg()) {
+		k = k.Sub(ONE_DECIMAL)
+		r = x.Sub(k.Mul(ln2Decimal))
+	}
+
+	epsilon := decimal.New(1, -prec)
+	sum := ONE_DECIMAL
+	term := ONE_DECIMAL
+	for n := int64(1); n < 1000; n++ {
+		term = term.Mul(r).DivRound(decimal.NewFromInt(n), workingPrec)
+		if term.Abs().LessThan(epsilon) {
+			break
+		}
+		sum = sum.Add(term)
+	}
+
+	kInt := k.IntPart()
+	powerOfTwo := TWO_DECIMAL.Pow(decimal.NewFromInt(kInt))
+	if kInt < 0 {
+		powerOfTwo = ONE_DECIMAL.DivRound(TWO_DECIMAL.Pow(decimal.NewFromInt(-kInt)), workingPrec)
+	}
+	return sum.Mul(powerOfTwo).Round(prec)
+}
+
+/* -------------------------------------------------------------------------------------------------
+DecLn: computes ln(x) to prec decimal digits without leaving decimal.Decimal. x is reduced to
+m in [1, 2) by dividing/multiplying out powers of two, then ln(m) is summed from the atanh series
+2*sum(y^(2n+1)/(2n+1)) with y = (m-1)/(m+1) until the next term is smaller than 10^-prec, and the
+discarded powers of two are added back as k*ln2.
+------------------------------------------------------------------------------------------------- */
+
+func DecLn(x decimal.Decimal, prec int32) decimal.Decimal {
+	if !x.IsPositive() {
+		return decimal.Zero
+	}
+	workingPrec := prec + 10
+
+	k := int64(0)
+	m := x
+	for m.GreaterThanOrEqual(TWO_DECIMAL) {
+		m = m.DivRound(TWO_DECIMAL, workingPrec)
+		k++
+	}
+	for m.LessThan(ONE_DECIMAL) {
+		m = m.Mul(TWO_DECIMAL)
+		k--
+	}
+
+	y := m.Sub(ONE_DECIMAL).DivRound(m.Add(ONE_DECIMAL), workingPrec)
+	ySquared := y.Mul(y)
+	epsilon := decimal.New(1, -prec)
+	sum := y
+	term := y
+	for n := int64(1); n < 1000; n++ {
+		term = term.Mul(ySquared)
+		addend := term.DivRound(decimal.NewFromInt(2*n+1), workingPrec)
+		if addend.Abs().LessThan(epsilon) {
+			break
+		}
+		sum = sum.Add(addend)
+	}
+
+	return sum.Mul(TWO_DECIMAL).Add(decimal.NewFromInt(k).Mul(ln2Decimal)).Round(prec)
+}
+
 /* -------------------------------------------------------------------------------------------------
 -------------------------------------------------------------------------------------------------*/
\ No newline at end of file